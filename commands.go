@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/config"
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+)
+
+// runCommand runs the maintenance command named by args[0] instead of the server.
+func runCommand(ctx context.Context, args []string, cfg *config.Config, imageService *services.ImageService, blogPostService *services.BlogPostService) error {
+	switch args[0] {
+	case "gc-uploads":
+		return gcUploads(ctx, args[1:], cfg, imageService)
+	case "migrate-image-keys":
+		return migrateImageKeys(ctx, args[1:], imageService)
+	case "render-posts":
+		return renderPosts(ctx, args[1:], blogPostService)
+	case "purge-trash":
+		return purgeTrash(ctx, args[1:], cfg, blogPostService)
+	default:
+		return fmt.Errorf("unknown command %q (available: gc-uploads, migrate-image-keys, render-posts, purge-trash)", args[0])
+	}
+}
+
+// gcUploads reports the uploads nothing in the database refers to and, unless
+// -dry-run is given, deletes those older than the grace period.
+// Usage: gc-uploads [-dry-run] [-grace <duration>]
+func gcUploads(ctx context.Context, args []string, cfg *config.Config, imageService *services.ImageService) error {
+	flags := flag.NewFlagSet("gc-uploads", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "report orphaned uploads without deleting them")
+	grace := flags.Duration("grace", cfg.UploadGCGracePeriod, "spare uploads younger than this")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	report, err := imageService.CollectOrphans(ctx, services.OrphanOptions{GracePeriod: *grace, DryRun: *dryRun})
+	if report == nil {
+		return err
+	}
+
+	for _, o := range report.Orphans {
+		fmt.Printf("orphan\t%s\t%d bytes\t%s\n", o.Key, o.Size, o.ModTime.Format(time.RFC3339))
+	}
+	fmt.Printf("Scanned %d files: %d orphaned (%d bytes), %d more unreferenced within the %s grace period.\n",
+		report.Scanned, len(report.Orphans), report.Bytes, report.Recent, *grace)
+	if *dryRun {
+		fmt.Println("Dry run: nothing was deleted.")
+	} else {
+		fmt.Printf("Deleted %d orphaned files.\n", report.Deleted)
+	}
+	return err
+}
+
+// migrateImageKeys rewrites the absolute image URLs stored by earlier versions to storage keys.
+// Usage: migrate-image-keys [-dry-run]
+func migrateImageKeys(ctx context.Context, args []string, imageService *services.ImageService) error {
+	flags := flag.NewFlagSet("migrate-image-keys", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "report the rows to rewrite without changing them")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	report, err := imageService.MigrateImageKeys(ctx, *dryRun)
+	if report == nil {
+		return err
+	}
+
+	for _, ref := range report.Skipped {
+		fmt.Printf("skipped\t%s\n", ref)
+	}
+	verb := "Rewrote"
+	if *dryRun {
+		verb = "Dry run: would rewrite"
+	}
+	fmt.Printf("%s %d blog posts, %d authors and %d media entries; skipped %d external URLs.\n",
+		verb, report.Posts, report.Authors, report.Media, len(report.Skipped))
+	return err
+}
+
+// renderPosts renders the content, table of contents and reading time of posts
+// written before they were stored, or of every post with -all.
+// Usage: render-posts [-all]
+func renderPosts(ctx context.Context, args []string, blogPostService *services.BlogPostService) error {
+	flags := flag.NewFlagSet("render-posts", flag.ContinueOnError)
+	all := flags.Bool("all", false, "re-render every post, not only those never rendered")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	n, err := blogPostService.RenderPosts(ctx, *all)
+	fmt.Printf("Rendered %d blog posts.\n", n)
+	return err
+}
+
+// purgeTrash deletes the posts trashed longer than the retention period, or
+// only lists them with -dry-run.
+// Usage: purge-trash [-dry-run] [-retention <duration>]
+func purgeTrash(ctx context.Context, args []string, cfg *config.Config, blogPostService *services.BlogPostService) error {
+	flags := flag.NewFlagSet("purge-trash", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "list the expired posts without deleting them")
+	retention := flags.Duration("retention", cfg.TrashRetention, "purge posts trashed longer ago than this")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	posts, err := blogPostService.PurgeTrash(ctx, *retention, *dryRun)
+	for _, p := range posts {
+		fmt.Printf("expired\t%s\t%s\n", p.Slug, p.DeletedAt.Format(time.RFC3339))
+	}
+	if *dryRun {
+		fmt.Printf("Dry run: %d blog posts trashed over %s ago would be purged.\n", len(posts), *retention)
+	} else {
+		fmt.Printf("Purged %d blog posts trashed over %s ago.\n", len(posts), *retention)
+	}
+	return err
+}
@@ -1,8 +1,16 @@
 package config
 
 import (
+	"fmt"
 	"log"
+	"net"
+	"net/url"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -16,8 +24,68 @@ type Config struct {
 	DatabaseName     string
 	DatabaseSSLMode  string
 	ServerPort       string
+	// PublicURL is the origin clients reach the API at, used to build the URLs
+	// of images served through the API. When empty, it is taken from each request.
+	PublicURL string
+	// TrustedProxies are the networks of the reverse proxies whose forwarded
+	// headers are honored for client IPs and request origins.
+	TrustedProxies []*net.IPNet
+	SessionTTL     time.Duration
+	// DefaultPageSize and MaxPageSize bound the limit query parameter of paginated listings.
+	DefaultPageSize int
+	MaxPageSize     int
+	// MaxUploadBytes, MaxImageDimension and MaxImagePixels bound image uploads.
+	MaxUploadBytes    int64
+	MaxImageDimension int
+	MaxImagePixels    int64
+	// ImageVariants are the resized widths generated for post images, narrowest first.
+	ImageVariants []ImageVariant
+	// ImageCacheDir holds on-the-fly transformed images, up to ImageCacheMaxBytes
+	// in total and for ImageCacheMaxAge (0 for no age limit) each.
+	// ImageTransformSizes and ImageTransformQualities whitelist the w/h and q
+	// parameters of transforms.
+	ImageCacheDir           string
+	ImageCacheMaxBytes      int64
+	ImageCacheMaxAge        time.Duration
+	ImageTransformSizes     []int
+	ImageTransformQualities []int
+	// ImageBaseURL, when set, is the URL prefix of image keys, such as a CDN in
+	// front of the API's image path. It takes precedence over S3PublicURL.
+	ImageBaseURL string
+	// StorageDriver selects where uploads are stored: "local" (UploadDir) or "s3".
+	StorageDriver string
+	UploadDir     string
+	// S3 settings, used when StorageDriver is "s3". S3PublicURL, when set, serves
+	// uploads straight from the bucket or a CDN instead of through the API.
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3UseSSL          bool
+	S3PublicURL       string
+	// UploadGCInterval is how often orphaned uploads are collected; 0 disables
+	// the schedule. UploadGCGracePeriod spares uploads younger than it.
+	UploadGCInterval    time.Duration
+	UploadGCGracePeriod time.Duration
+	// TrashPurgeInterval is how often posts trashed longer than TrashRetention
+	// are deleted for good; 0 disables the schedule.
+	TrashPurgeInterval time.Duration
+	TrashRetention     time.Duration
+	// AdminEmail and AdminPassword seed the first admin user when no users exist.
+	AdminEmail    string
+	AdminPassword string
 }
 
+// ImageVariant names a resized width generated for post images.
+type ImageVariant struct {
+	Name  string
+	Width int
+}
+
+// defaultImageVariants is used when IMAGE_VARIANTS is not set.
+const defaultImageVariants = "thumbnail:320,card:768,full:1600"
+
 // LoadConfig loads configuration from environment variables or .env file
 func LoadConfig() *Config {
 	err := godotenv.Load()
@@ -60,13 +128,239 @@ func LoadConfig() *Config {
 		log.Fatal("PORT environment variable not set.")
 	}
 
-	return &Config{
-		DatabaseHost:     dbHost,
-		DatabasePort:     dbPort,
-		DatabaseUser:     dbUser,
-		DatabasePassword: dbPassword,
-		DatabaseName:     dbName,
-		DatabaseSSLMode:  dbSSLMode,
-		ServerPort:       serverPort,
+	publicURL := baseURLEnv("PUBLIC_URL", os.Getenv("PUBLIC_URL"))
+	trustedProxies := cidrListEnv("TRUSTED_PROXIES")
+	imageBaseURL := baseURLEnv("IMAGE_BASE_URL", os.Getenv("IMAGE_BASE_URL"))
+
+	sessionTTL := 24 * time.Hour // default value if not set
+	if v := os.Getenv("SESSION_TTL"); v != "" {
+		sessionTTL, err = time.ParseDuration(v)
+		if err != nil || sessionTTL <= 0 {
+			log.Fatalf("Invalid SESSION_TTL value %q: expected a positive duration such as 24h", v)
+		}
+	}
+
+	uploadGCInterval := durationEnv("UPLOAD_GC_INTERVAL", 24*time.Hour)
+	uploadGCGracePeriod := durationEnv("UPLOAD_GC_GRACE_PERIOD", 24*time.Hour)
+	trashPurgeInterval := durationEnv("TRASH_PURGE_INTERVAL", 24*time.Hour)
+	trashRetention := durationEnv("TRASH_RETENTION", 30*24*time.Hour)
+
+	defaultPageSize := int(positiveIntEnv("DEFAULT_PAGE_SIZE", 10))
+	maxPageSize := int(positiveIntEnv("MAX_PAGE_SIZE", 100))
+	if defaultPageSize > maxPageSize {
+		log.Fatalf("DEFAULT_PAGE_SIZE (%d) cannot exceed MAX_PAGE_SIZE (%d)", defaultPageSize, maxPageSize)
+	}
+
+	storageDriver := os.Getenv("STORAGE_DRIVER")
+	if storageDriver == "" {
+		storageDriver = "local" // default value if not set
+	}
+
+	uploadDir := os.Getenv("UPLOAD_DIR")
+	if uploadDir == "" {
+		uploadDir = "./uploads" // default value if not set
 	}
+
+	s3UseSSL := true // default value if not set
+	if v := os.Getenv("S3_USE_SSL"); v != "" {
+		s3UseSSL, err = strconv.ParseBool(v)
+		if err != nil {
+			log.Fatalf("Invalid S3_USE_SSL value %q: expected true or false", v)
+		}
+	}
+
+	maxUploadBytes := positiveIntEnv("MAX_UPLOAD_BYTES", 10<<20)           // 10 MiB
+	maxImageDimension := int(positiveIntEnv("MAX_IMAGE_DIMENSION", 10000)) // pixels per side
+	maxImagePixels := positiveIntEnv("MAX_IMAGE_PIXELS", 40_000_000)       // 40 megapixels
+
+	imageVariants := os.Getenv("IMAGE_VARIANTS")
+	if imageVariants == "" {
+		imageVariants = defaultImageVariants
+	}
+	variants, err := parseImageVariants(imageVariants)
+	if err != nil {
+		log.Fatalf("Invalid IMAGE_VARIANTS value %q: %v", imageVariants, err)
+	}
+
+	imageCacheDir := os.Getenv("IMAGE_CACHE_DIR")
+	if imageCacheDir == "" {
+		imageCacheDir = "./cache/images" // default value if not set
+	}
+	imageCacheMaxBytes := positiveIntEnv("IMAGE_CACHE_MAX_BYTES", 1<<30) // 1 GiB
+	imageCacheMaxAge := durationEnv("IMAGE_CACHE_MAX_AGE", 30*24*time.Hour)
+	transformSizes := positiveIntListEnv("IMAGE_TRANSFORM_SIZES", "64,128,160,240,320,480,640,768,960,1024,1280,1600,1920")
+	transformQualities := positiveIntListEnv("IMAGE_TRANSFORM_QUALITIES", "50,60,70,75,80,85,90")
+
+	cfg := &Config{
+		DatabaseHost:            dbHost,
+		DatabasePort:            dbPort,
+		DatabaseUser:            dbUser,
+		DatabasePassword:        dbPassword,
+		DatabaseName:            dbName,
+		DatabaseSSLMode:         dbSSLMode,
+		ServerPort:              serverPort,
+		PublicURL:               publicURL,
+		TrustedProxies:          trustedProxies,
+		ImageBaseURL:            imageBaseURL,
+		SessionTTL:              sessionTTL,
+		DefaultPageSize:         defaultPageSize,
+		MaxPageSize:             maxPageSize,
+		MaxUploadBytes:          maxUploadBytes,
+		MaxImageDimension:       maxImageDimension,
+		MaxImagePixels:          maxImagePixels,
+		ImageVariants:           variants,
+		ImageCacheDir:           imageCacheDir,
+		ImageCacheMaxBytes:      imageCacheMaxBytes,
+		ImageCacheMaxAge:        imageCacheMaxAge,
+		ImageTransformSizes:     transformSizes,
+		ImageTransformQualities: transformQualities,
+		StorageDriver:           storageDriver,
+		UploadDir:               uploadDir,
+		S3Endpoint:              os.Getenv("S3_ENDPOINT"),
+		S3Region:                os.Getenv("S3_REGION"),
+		S3Bucket:                os.Getenv("S3_BUCKET"),
+		S3AccessKeyID:           os.Getenv("S3_ACCESS_KEY_ID"),
+		S3SecretAccessKey:       os.Getenv("S3_SECRET_ACCESS_KEY"),
+		S3UseSSL:                s3UseSSL,
+		S3PublicURL:             os.Getenv("S3_PUBLIC_URL"),
+		UploadGCInterval:        uploadGCInterval,
+		UploadGCGracePeriod:     uploadGCGracePeriod,
+		TrashPurgeInterval:      trashPurgeInterval,
+		TrashRetention:          trashRetention,
+		AdminEmail:              os.Getenv("ADMIN_EMAIL"),
+		AdminPassword:           os.Getenv("ADMIN_PASSWORD"),
+	}
+
+	switch cfg.StorageDriver {
+	case "local":
+	case "s3":
+		if cfg.S3Endpoint == "" || cfg.S3Bucket == "" {
+			log.Fatal("S3_ENDPOINT and S3_BUCKET must be set when STORAGE_DRIVER is s3.")
+		}
+	default:
+		log.Fatalf("Invalid STORAGE_DRIVER value %q: expected local or s3", cfg.StorageDriver)
+	}
+
+	return cfg
+}
+
+// positiveIntEnv reads a positive integer from the environment variable name,
+// returning def when it is not set.
+func positiveIntEnv(name string, def int64) int64 {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 1 {
+		log.Fatalf("Invalid %s value %q: expected a positive integer", name, v)
+	}
+	return n
+}
+
+// baseURLEnv validates v, the value of the named variable, as an absolute http(s)
+// URL and returns it without a trailing slash. An empty v is returned as is.
+func baseURLEnv(name, v string) string {
+	if v == "" {
+		return ""
+	}
+	u, err := url.Parse(v)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		log.Fatalf("Invalid %s value %q: expected an absolute URL such as https://example.com", name, v)
+	}
+	return strings.TrimSuffix(v, "/")
+}
+
+// cidrListEnv reads a comma-separated list of CIDR networks or single IP
+// addresses from the environment variable name, returning nil when it is not set.
+func cidrListEnv(name string) []*net.IPNet {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	var networks []*net.IPNet
+	for _, item := range strings.Split(v, ",") {
+		item = strings.TrimSpace(item)
+		if ip := net.ParseIP(item); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				bits = 8 * net.IPv4len
+			}
+			item = fmt.Sprintf("%s/%d", item, bits)
+		}
+		_, network, err := net.ParseCIDR(item)
+		if err != nil {
+			log.Fatalf("Invalid %s value %q: expected comma-separated CIDRs such as 10.0.0.0/8", name, v)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// durationEnv reads a non-negative duration such as "24h" from the environment,
+// returning def when the variable is not set.
+func durationEnv(name string, def time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		log.Fatalf("Invalid %s value %q: expected a duration such as 24h", name, v)
+	}
+	return d
+}
+
+// positiveIntListEnv reads a comma-separated list of positive integers from the
+// environment variable name, using def when it is not set.
+func positiveIntListEnv(name, def string) []int {
+	v := os.Getenv(name)
+	if v == "" {
+		v = def
+	}
+	var list []int
+	for _, item := range strings.Split(v, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil || n < 1 {
+			log.Fatalf("Invalid %s value %q: expected comma-separated positive integers", name, v)
+		}
+		list = append(list, n)
+	}
+	return list
+}
+
+// variantNamePattern matches valid image variant names.
+var variantNamePattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// parseImageVariants parses a comma-separated list of name:width pairs,
+// such as "thumbnail:320,card:768", sorted by width. "none" disables variants.
+func parseImageVariants(s string) ([]ImageVariant, error) {
+	if s == "none" {
+		return nil, nil
+	}
+
+	var variants []ImageVariant
+	seen := map[string]bool{}
+	for _, pair := range strings.Split(s, ",") {
+		name, width, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("expected name:width, got %q", pair)
+		}
+		if !variantNamePattern.MatchString(name) || name == "original" || name == "srcset" {
+			return nil, fmt.Errorf("invalid variant name %q", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate variant name %q", name)
+		}
+		seen[name] = true
+
+		w, err := strconv.Atoi(width)
+		if err != nil || w < 1 {
+			return nil, fmt.Errorf("invalid width %q for variant %q", width, name)
+		}
+		variants = append(variants, ImageVariant{Name: name, Width: w})
+	}
+
+	sort.Slice(variants, func(i, j int) bool { return variants[i].Width < variants[j].Width })
+	return variants, nil
 }
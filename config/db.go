@@ -5,10 +5,25 @@ import (
 	"fmt"
 	"log"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/AdongoJr2/technoprise-backend/ent"
 	_ "github.com/lib/pq"
 )
 
+// searchMigrations add the full-text search column and index that Ent's
+// schema cannot express: a stored, generated tsvector over the post's title,
+// excerpt and content (weighted in that order) and a GIN index on it.
+// Requires PostgreSQL 12 or newer.
+var searchMigrations = []string{
+	`ALTER TABLE blog_posts ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (
+			setweight(to_tsvector('english'::regconfig, coalesce(title, '')), 'A') ||
+			setweight(to_tsvector('english'::regconfig, coalesce(excerpt, '')), 'B') ||
+			setweight(to_tsvector('english'::regconfig, coalesce(content, '')), 'C')
+		) STORED`,
+	`CREATE INDEX IF NOT EXISTS blogpost_search_vector ON blog_posts USING GIN (search_vector)`,
+}
+
 // ConnectDB initializes and returns an Ent client connected to PostgreSQL.
 // It also runs database migrations.
 func ConnectDB(cfg *Config) (*ent.Client, error) {
@@ -21,10 +36,11 @@ func ConnectDB(cfg *Config) (*ent.Client, error) {
 		cfg.DatabaseSSLMode,
 	)
 
-	client, err := ent.Open("postgres", databaseURL)
+	drv, err := entsql.Open("postgres", databaseURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed opening connection to postgres: %w", err)
 	}
+	client := ent.NewClient(ent.Driver(drv))
 
 	// Run migration to create the schema.
 	// This is suitable for development. For production, consider
@@ -33,6 +49,11 @@ func ConnectDB(cfg *Config) (*ent.Client, error) {
 	if err := client.Schema.Create(context.Background()); err != nil {
 		return nil, fmt.Errorf("failed creating schema resources: %w", err)
 	}
+	for _, stmt := range searchMigrations {
+		if _, err := drv.DB().ExecContext(context.Background(), stmt); err != nil {
+			return nil, fmt.Errorf("failed creating search index: %w", err)
+		}
+	}
 	log.Println("Database migrations completed successfully.")
 
 	return client, nil
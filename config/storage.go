@@ -0,0 +1,34 @@
+package config
+
+import (
+	"context"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/storage"
+)
+
+// NewStorage creates the upload storage backend selected by cfg.StorageDriver.
+// publicPath is the API path uploads are served from when they are not
+// served directly by the backend or a CDN. Without a PublicURL it is left
+// relative, to be completed with each request's origin.
+func NewStorage(ctx context.Context, cfg *Config, publicPath string) (storage.Storage, error) {
+	baseURL := cfg.ImageBaseURL
+	if baseURL == "" && cfg.StorageDriver == "s3" {
+		baseURL = cfg.S3PublicURL
+	}
+	if baseURL == "" {
+		baseURL = cfg.PublicURL + publicPath
+	}
+
+	if cfg.StorageDriver == "s3" {
+		return storage.NewS3(ctx, storage.S3Options{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UseSSL:          cfg.S3UseSSL,
+			BaseURL:         baseURL,
+		})
+	}
+	return storage.NewLocal(cfg.UploadDir, baseURL)
+}
@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+)
+
+// Author is the model entity for the Author schema.
+type Author struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Bio holds the value of the "bio" field.
+	Bio string `json:"bio,omitempty"`
+	// Avatar holds the value of the "avatar" field.
+	Avatar string `json:"avatar,omitempty"`
+	// SocialLinks holds the value of the "social_links" field.
+	SocialLinks map[string]string `json:"social_links,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AuthorQuery when eager-loading is set.
+	Edges               AuthorEdges `json:"edges"`
+	user_author_profile *int
+	selectValues        sql.SelectValues
+}
+
+// AuthorEdges holds the relations/edges for other nodes in the graph.
+type AuthorEdges struct {
+	// Posts holds the value of the posts edge.
+	Posts []*BlogPost `json:"posts,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PostsOrErr returns the Posts value or an error if the edge
+// was not loaded in eager-loading.
+func (e AuthorEdges) PostsOrErr() ([]*BlogPost, error) {
+	if e.loadedTypes[0] {
+		return e.Posts, nil
+	}
+	return nil, &NotLoadedError{edge: "posts"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AuthorEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Author) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case author.FieldSocialLinks:
+			values[i] = new([]byte)
+		case author.FieldID:
+			values[i] = new(sql.NullInt64)
+		case author.FieldName, author.FieldSlug, author.FieldBio, author.FieldAvatar:
+			values[i] = new(sql.NullString)
+		case author.FieldCreateTime, author.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case author.ForeignKeys[0]: // user_author_profile
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Author fields.
+func (a *Author) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case author.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case author.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				a.CreateTime = value.Time
+			}
+		case author.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				a.UpdateTime = value.Time
+			}
+		case author.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				a.Name = value.String
+			}
+		case author.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				a.Slug = value.String
+			}
+		case author.FieldBio:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bio", values[i])
+			} else if value.Valid {
+				a.Bio = value.String
+			}
+		case author.FieldAvatar:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar", values[i])
+			} else if value.Valid {
+				a.Avatar = value.String
+			}
+		case author.FieldSocialLinks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field social_links", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.SocialLinks); err != nil {
+					return fmt.Errorf("unmarshal field social_links: %w", err)
+				}
+			}
+		case author.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_author_profile", value)
+			} else if value.Valid {
+				a.user_author_profile = new(int)
+				*a.user_author_profile = int(value.Int64)
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Author.
+// This includes values selected through modifiers, order, etc.
+func (a *Author) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryPosts queries the "posts" edge of the Author entity.
+func (a *Author) QueryPosts() *BlogPostQuery {
+	return NewAuthorClient(a.config).QueryPosts(a)
+}
+
+// QueryUser queries the "user" edge of the Author entity.
+func (a *Author) QueryUser() *UserQuery {
+	return NewAuthorClient(a.config).QueryUser(a)
+}
+
+// Update returns a builder for updating this Author.
+// Note that you need to call Author.Unwrap() before calling this method if this Author
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Author) Update() *AuthorUpdateOne {
+	return NewAuthorClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Author entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Author) Unwrap() *Author {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Author is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Author) String() string {
+	var builder strings.Builder
+	builder.WriteString("Author(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(a.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(a.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(a.Name)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(a.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("bio=")
+	builder.WriteString(a.Bio)
+	builder.WriteString(", ")
+	builder.WriteString("avatar=")
+	builder.WriteString(a.Avatar)
+	builder.WriteString(", ")
+	builder.WriteString("social_links=")
+	builder.WriteString(fmt.Sprintf("%v", a.SocialLinks))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Authors is a parsable slice of Author.
+type Authors []*Author
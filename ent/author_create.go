@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+)
+
+// AuthorCreate is the builder for creating a Author entity.
+type AuthorCreate struct {
+	config
+	mutation *AuthorMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ac *AuthorCreate) SetCreateTime(t time.Time) *AuthorCreate {
+	ac.mutation.SetCreateTime(t)
+	return ac
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ac *AuthorCreate) SetNillableCreateTime(t *time.Time) *AuthorCreate {
+	if t != nil {
+		ac.SetCreateTime(*t)
+	}
+	return ac
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ac *AuthorCreate) SetUpdateTime(t time.Time) *AuthorCreate {
+	ac.mutation.SetUpdateTime(t)
+	return ac
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ac *AuthorCreate) SetNillableUpdateTime(t *time.Time) *AuthorCreate {
+	if t != nil {
+		ac.SetUpdateTime(*t)
+	}
+	return ac
+}
+
+// SetName sets the "name" field.
+func (ac *AuthorCreate) SetName(s string) *AuthorCreate {
+	ac.mutation.SetName(s)
+	return ac
+}
+
+// SetSlug sets the "slug" field.
+func (ac *AuthorCreate) SetSlug(s string) *AuthorCreate {
+	ac.mutation.SetSlug(s)
+	return ac
+}
+
+// SetBio sets the "bio" field.
+func (ac *AuthorCreate) SetBio(s string) *AuthorCreate {
+	ac.mutation.SetBio(s)
+	return ac
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (ac *AuthorCreate) SetNillableBio(s *string) *AuthorCreate {
+	if s != nil {
+		ac.SetBio(*s)
+	}
+	return ac
+}
+
+// SetAvatar sets the "avatar" field.
+func (ac *AuthorCreate) SetAvatar(s string) *AuthorCreate {
+	ac.mutation.SetAvatar(s)
+	return ac
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (ac *AuthorCreate) SetNillableAvatar(s *string) *AuthorCreate {
+	if s != nil {
+		ac.SetAvatar(*s)
+	}
+	return ac
+}
+
+// SetSocialLinks sets the "social_links" field.
+func (ac *AuthorCreate) SetSocialLinks(m map[string]string) *AuthorCreate {
+	ac.mutation.SetSocialLinks(m)
+	return ac
+}
+
+// AddPostIDs adds the "posts" edge to the BlogPost entity by IDs.
+func (ac *AuthorCreate) AddPostIDs(ids ...int) *AuthorCreate {
+	ac.mutation.AddPostIDs(ids...)
+	return ac
+}
+
+// AddPosts adds the "posts" edges to the BlogPost entity.
+func (ac *AuthorCreate) AddPosts(b ...*BlogPost) *AuthorCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ac.AddPostIDs(ids...)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ac *AuthorCreate) SetUserID(id int) *AuthorCreate {
+	ac.mutation.SetUserID(id)
+	return ac
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (ac *AuthorCreate) SetNillableUserID(id *int) *AuthorCreate {
+	if id != nil {
+		ac = ac.SetUserID(*id)
+	}
+	return ac
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ac *AuthorCreate) SetUser(u *User) *AuthorCreate {
+	return ac.SetUserID(u.ID)
+}
+
+// Mutation returns the AuthorMutation object of the builder.
+func (ac *AuthorCreate) Mutation() *AuthorMutation {
+	return ac.mutation
+}
+
+// Save creates the Author in the database.
+func (ac *AuthorCreate) Save(ctx context.Context) (*Author, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AuthorCreate) SaveX(ctx context.Context) *Author {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AuthorCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AuthorCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AuthorCreate) defaults() {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		v := author.DefaultCreateTime()
+		ac.mutation.SetCreateTime(v)
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		v := author.DefaultUpdateTime()
+		ac.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AuthorCreate) check() error {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Author.create_time"`)}
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Author.update_time"`)}
+	}
+	if _, ok := ac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Author.name"`)}
+	}
+	if v, ok := ac.mutation.Name(); ok {
+		if err := author.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Author.name": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "Author.slug"`)}
+	}
+	if v, ok := ac.mutation.Slug(); ok {
+		if err := author.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Author.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ac *AuthorCreate) sqlSave(ctx context.Context) (*Author, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AuthorCreate) createSpec() (*Author, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Author{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(author.Table, sqlgraph.NewFieldSpec(author.FieldID, field.TypeInt))
+	)
+	if value, ok := ac.mutation.CreateTime(); ok {
+		_spec.SetField(author.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ac.mutation.UpdateTime(); ok {
+		_spec.SetField(author.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ac.mutation.Name(); ok {
+		_spec.SetField(author.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ac.mutation.Slug(); ok {
+		_spec.SetField(author.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := ac.mutation.Bio(); ok {
+		_spec.SetField(author.FieldBio, field.TypeString, value)
+		_node.Bio = value
+	}
+	if value, ok := ac.mutation.Avatar(); ok {
+		_spec.SetField(author.FieldAvatar, field.TypeString, value)
+		_node.Avatar = value
+	}
+	if value, ok := ac.mutation.SocialLinks(); ok {
+		_spec.SetField(author.FieldSocialLinks, field.TypeJSON, value)
+		_node.SocialLinks = value
+	}
+	if nodes := ac.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   author.PostsTable,
+			Columns: []string{author.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   author.UserTable,
+			Columns: []string{author.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_author_profile = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AuthorCreateBulk is the builder for creating many Author entities in bulk.
+type AuthorCreateBulk struct {
+	config
+	err      error
+	builders []*AuthorCreate
+}
+
+// Save creates the Author entities in the database.
+func (acb *AuthorCreateBulk) Save(ctx context.Context) ([]*Author, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Author, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AuthorCreateBulk) SaveX(ctx context.Context) []*Author {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AuthorCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AuthorCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,744 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+)
+
+// AuthorUpdate is the builder for updating Author entities.
+type AuthorUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuthorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuthorUpdate builder.
+func (au *AuthorUpdate) Where(ps ...predicate.Author) *AuthorUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (au *AuthorUpdate) SetUpdateTime(t time.Time) *AuthorUpdate {
+	au.mutation.SetUpdateTime(t)
+	return au
+}
+
+// SetName sets the "name" field.
+func (au *AuthorUpdate) SetName(s string) *AuthorUpdate {
+	au.mutation.SetName(s)
+	return au
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (au *AuthorUpdate) SetNillableName(s *string) *AuthorUpdate {
+	if s != nil {
+		au.SetName(*s)
+	}
+	return au
+}
+
+// SetSlug sets the "slug" field.
+func (au *AuthorUpdate) SetSlug(s string) *AuthorUpdate {
+	au.mutation.SetSlug(s)
+	return au
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (au *AuthorUpdate) SetNillableSlug(s *string) *AuthorUpdate {
+	if s != nil {
+		au.SetSlug(*s)
+	}
+	return au
+}
+
+// SetBio sets the "bio" field.
+func (au *AuthorUpdate) SetBio(s string) *AuthorUpdate {
+	au.mutation.SetBio(s)
+	return au
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (au *AuthorUpdate) SetNillableBio(s *string) *AuthorUpdate {
+	if s != nil {
+		au.SetBio(*s)
+	}
+	return au
+}
+
+// ClearBio clears the value of the "bio" field.
+func (au *AuthorUpdate) ClearBio() *AuthorUpdate {
+	au.mutation.ClearBio()
+	return au
+}
+
+// SetAvatar sets the "avatar" field.
+func (au *AuthorUpdate) SetAvatar(s string) *AuthorUpdate {
+	au.mutation.SetAvatar(s)
+	return au
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (au *AuthorUpdate) SetNillableAvatar(s *string) *AuthorUpdate {
+	if s != nil {
+		au.SetAvatar(*s)
+	}
+	return au
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (au *AuthorUpdate) ClearAvatar() *AuthorUpdate {
+	au.mutation.ClearAvatar()
+	return au
+}
+
+// SetSocialLinks sets the "social_links" field.
+func (au *AuthorUpdate) SetSocialLinks(m map[string]string) *AuthorUpdate {
+	au.mutation.SetSocialLinks(m)
+	return au
+}
+
+// ClearSocialLinks clears the value of the "social_links" field.
+func (au *AuthorUpdate) ClearSocialLinks() *AuthorUpdate {
+	au.mutation.ClearSocialLinks()
+	return au
+}
+
+// AddPostIDs adds the "posts" edge to the BlogPost entity by IDs.
+func (au *AuthorUpdate) AddPostIDs(ids ...int) *AuthorUpdate {
+	au.mutation.AddPostIDs(ids...)
+	return au
+}
+
+// AddPosts adds the "posts" edges to the BlogPost entity.
+func (au *AuthorUpdate) AddPosts(b ...*BlogPost) *AuthorUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return au.AddPostIDs(ids...)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (au *AuthorUpdate) SetUserID(id int) *AuthorUpdate {
+	au.mutation.SetUserID(id)
+	return au
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (au *AuthorUpdate) SetNillableUserID(id *int) *AuthorUpdate {
+	if id != nil {
+		au = au.SetUserID(*id)
+	}
+	return au
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (au *AuthorUpdate) SetUser(u *User) *AuthorUpdate {
+	return au.SetUserID(u.ID)
+}
+
+// Mutation returns the AuthorMutation object of the builder.
+func (au *AuthorUpdate) Mutation() *AuthorMutation {
+	return au.mutation
+}
+
+// ClearPosts clears all "posts" edges to the BlogPost entity.
+func (au *AuthorUpdate) ClearPosts() *AuthorUpdate {
+	au.mutation.ClearPosts()
+	return au
+}
+
+// RemovePostIDs removes the "posts" edge to BlogPost entities by IDs.
+func (au *AuthorUpdate) RemovePostIDs(ids ...int) *AuthorUpdate {
+	au.mutation.RemovePostIDs(ids...)
+	return au
+}
+
+// RemovePosts removes "posts" edges to BlogPost entities.
+func (au *AuthorUpdate) RemovePosts(b ...*BlogPost) *AuthorUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return au.RemovePostIDs(ids...)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (au *AuthorUpdate) ClearUser() *AuthorUpdate {
+	au.mutation.ClearUser()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AuthorUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AuthorUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AuthorUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AuthorUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AuthorUpdate) defaults() {
+	if _, ok := au.mutation.UpdateTime(); !ok {
+		v := author.UpdateDefaultUpdateTime()
+		au.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AuthorUpdate) check() error {
+	if v, ok := au.mutation.Name(); ok {
+		if err := author.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Author.name": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Slug(); ok {
+		if err := author.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Author.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (au *AuthorUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthorUpdate {
+	au.modifiers = append(au.modifiers, modifiers...)
+	return au
+}
+
+func (au *AuthorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(author.Table, author.Columns, sqlgraph.NewFieldSpec(author.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.UpdateTime(); ok {
+		_spec.SetField(author.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.Name(); ok {
+		_spec.SetField(author.FieldName, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Slug(); ok {
+		_spec.SetField(author.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Bio(); ok {
+		_spec.SetField(author.FieldBio, field.TypeString, value)
+	}
+	if au.mutation.BioCleared() {
+		_spec.ClearField(author.FieldBio, field.TypeString)
+	}
+	if value, ok := au.mutation.Avatar(); ok {
+		_spec.SetField(author.FieldAvatar, field.TypeString, value)
+	}
+	if au.mutation.AvatarCleared() {
+		_spec.ClearField(author.FieldAvatar, field.TypeString)
+	}
+	if value, ok := au.mutation.SocialLinks(); ok {
+		_spec.SetField(author.FieldSocialLinks, field.TypeJSON, value)
+	}
+	if au.mutation.SocialLinksCleared() {
+		_spec.ClearField(author.FieldSocialLinks, field.TypeJSON)
+	}
+	if au.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   author.PostsTable,
+			Columns: []string{author.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedPostsIDs(); len(nodes) > 0 && !au.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   author.PostsTable,
+			Columns: []string{author.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   author.PostsTable,
+			Columns: []string{author.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   author.UserTable,
+			Columns: []string{author.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   author.UserTable,
+			Columns: []string{author.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(au.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{author.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AuthorUpdateOne is the builder for updating a single Author entity.
+type AuthorUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuthorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (auo *AuthorUpdateOne) SetUpdateTime(t time.Time) *AuthorUpdateOne {
+	auo.mutation.SetUpdateTime(t)
+	return auo
+}
+
+// SetName sets the "name" field.
+func (auo *AuthorUpdateOne) SetName(s string) *AuthorUpdateOne {
+	auo.mutation.SetName(s)
+	return auo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (auo *AuthorUpdateOne) SetNillableName(s *string) *AuthorUpdateOne {
+	if s != nil {
+		auo.SetName(*s)
+	}
+	return auo
+}
+
+// SetSlug sets the "slug" field.
+func (auo *AuthorUpdateOne) SetSlug(s string) *AuthorUpdateOne {
+	auo.mutation.SetSlug(s)
+	return auo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (auo *AuthorUpdateOne) SetNillableSlug(s *string) *AuthorUpdateOne {
+	if s != nil {
+		auo.SetSlug(*s)
+	}
+	return auo
+}
+
+// SetBio sets the "bio" field.
+func (auo *AuthorUpdateOne) SetBio(s string) *AuthorUpdateOne {
+	auo.mutation.SetBio(s)
+	return auo
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (auo *AuthorUpdateOne) SetNillableBio(s *string) *AuthorUpdateOne {
+	if s != nil {
+		auo.SetBio(*s)
+	}
+	return auo
+}
+
+// ClearBio clears the value of the "bio" field.
+func (auo *AuthorUpdateOne) ClearBio() *AuthorUpdateOne {
+	auo.mutation.ClearBio()
+	return auo
+}
+
+// SetAvatar sets the "avatar" field.
+func (auo *AuthorUpdateOne) SetAvatar(s string) *AuthorUpdateOne {
+	auo.mutation.SetAvatar(s)
+	return auo
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (auo *AuthorUpdateOne) SetNillableAvatar(s *string) *AuthorUpdateOne {
+	if s != nil {
+		auo.SetAvatar(*s)
+	}
+	return auo
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (auo *AuthorUpdateOne) ClearAvatar() *AuthorUpdateOne {
+	auo.mutation.ClearAvatar()
+	return auo
+}
+
+// SetSocialLinks sets the "social_links" field.
+func (auo *AuthorUpdateOne) SetSocialLinks(m map[string]string) *AuthorUpdateOne {
+	auo.mutation.SetSocialLinks(m)
+	return auo
+}
+
+// ClearSocialLinks clears the value of the "social_links" field.
+func (auo *AuthorUpdateOne) ClearSocialLinks() *AuthorUpdateOne {
+	auo.mutation.ClearSocialLinks()
+	return auo
+}
+
+// AddPostIDs adds the "posts" edge to the BlogPost entity by IDs.
+func (auo *AuthorUpdateOne) AddPostIDs(ids ...int) *AuthorUpdateOne {
+	auo.mutation.AddPostIDs(ids...)
+	return auo
+}
+
+// AddPosts adds the "posts" edges to the BlogPost entity.
+func (auo *AuthorUpdateOne) AddPosts(b ...*BlogPost) *AuthorUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return auo.AddPostIDs(ids...)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (auo *AuthorUpdateOne) SetUserID(id int) *AuthorUpdateOne {
+	auo.mutation.SetUserID(id)
+	return auo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (auo *AuthorUpdateOne) SetNillableUserID(id *int) *AuthorUpdateOne {
+	if id != nil {
+		auo = auo.SetUserID(*id)
+	}
+	return auo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (auo *AuthorUpdateOne) SetUser(u *User) *AuthorUpdateOne {
+	return auo.SetUserID(u.ID)
+}
+
+// Mutation returns the AuthorMutation object of the builder.
+func (auo *AuthorUpdateOne) Mutation() *AuthorMutation {
+	return auo.mutation
+}
+
+// ClearPosts clears all "posts" edges to the BlogPost entity.
+func (auo *AuthorUpdateOne) ClearPosts() *AuthorUpdateOne {
+	auo.mutation.ClearPosts()
+	return auo
+}
+
+// RemovePostIDs removes the "posts" edge to BlogPost entities by IDs.
+func (auo *AuthorUpdateOne) RemovePostIDs(ids ...int) *AuthorUpdateOne {
+	auo.mutation.RemovePostIDs(ids...)
+	return auo
+}
+
+// RemovePosts removes "posts" edges to BlogPost entities.
+func (auo *AuthorUpdateOne) RemovePosts(b ...*BlogPost) *AuthorUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return auo.RemovePostIDs(ids...)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (auo *AuthorUpdateOne) ClearUser() *AuthorUpdateOne {
+	auo.mutation.ClearUser()
+	return auo
+}
+
+// Where appends a list predicates to the AuthorUpdate builder.
+func (auo *AuthorUpdateOne) Where(ps ...predicate.Author) *AuthorUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AuthorUpdateOne) Select(field string, fields ...string) *AuthorUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Author entity.
+func (auo *AuthorUpdateOne) Save(ctx context.Context) (*Author, error) {
+	auo.defaults()
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AuthorUpdateOne) SaveX(ctx context.Context) *Author {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AuthorUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AuthorUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AuthorUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdateTime(); !ok {
+		v := author.UpdateDefaultUpdateTime()
+		auo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AuthorUpdateOne) check() error {
+	if v, ok := auo.mutation.Name(); ok {
+		if err := author.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Author.name": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Slug(); ok {
+		if err := author.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Author.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (auo *AuthorUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthorUpdateOne {
+	auo.modifiers = append(auo.modifiers, modifiers...)
+	return auo
+}
+
+func (auo *AuthorUpdateOne) sqlSave(ctx context.Context) (_node *Author, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(author.Table, author.Columns, sqlgraph.NewFieldSpec(author.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Author.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, author.FieldID)
+		for _, f := range fields {
+			if !author.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != author.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.UpdateTime(); ok {
+		_spec.SetField(author.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.Name(); ok {
+		_spec.SetField(author.FieldName, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Slug(); ok {
+		_spec.SetField(author.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Bio(); ok {
+		_spec.SetField(author.FieldBio, field.TypeString, value)
+	}
+	if auo.mutation.BioCleared() {
+		_spec.ClearField(author.FieldBio, field.TypeString)
+	}
+	if value, ok := auo.mutation.Avatar(); ok {
+		_spec.SetField(author.FieldAvatar, field.TypeString, value)
+	}
+	if auo.mutation.AvatarCleared() {
+		_spec.ClearField(author.FieldAvatar, field.TypeString)
+	}
+	if value, ok := auo.mutation.SocialLinks(); ok {
+		_spec.SetField(author.FieldSocialLinks, field.TypeJSON, value)
+	}
+	if auo.mutation.SocialLinksCleared() {
+		_spec.ClearField(author.FieldSocialLinks, field.TypeJSON)
+	}
+	if auo.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   author.PostsTable,
+			Columns: []string{author.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedPostsIDs(); len(nodes) > 0 && !auo.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   author.PostsTable,
+			Columns: []string{author.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   author.PostsTable,
+			Columns: []string{author.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   author.UserTable,
+			Columns: []string{author.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   author.UserTable,
+			Columns: []string{author.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(auo.modifiers...)
+	_node = &Author{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{author.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -3,13 +3,19 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
 	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/category"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
 )
 
 // BlogPost is the model entity for the BlogPost schema.
@@ -21,19 +27,136 @@ type BlogPost struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Slug holds the value of the "slug" field.
 	Slug string `json:"slug,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// ContentFormat holds the value of the "content_format" field.
+	ContentFormat blogpost.ContentFormat `json:"content_format,omitempty"`
+	// ContentHTML holds the value of the "content_html" field.
+	ContentHTML string `json:"content_html,omitempty"`
+	// Toc holds the value of the "toc" field.
+	Toc []schematype.TOCEntry `json:"toc,omitempty"`
+	// WordCount holds the value of the "word_count" field.
+	WordCount int `json:"word_count,omitempty"`
+	// ReadingTime holds the value of the "reading_time" field.
+	ReadingTime int `json:"reading_time,omitempty"`
 	// Excerpt holds the value of the "excerpt" field.
 	Excerpt string `json:"excerpt,omitempty"`
+	// ExcerptGenerated holds the value of the "excerpt_generated" field.
+	ExcerptGenerated bool `json:"excerpt_generated,omitempty"`
 	// Image holds the value of the "image" field.
 	Image string `json:"image,omitempty"`
+	// ImageVariants holds the value of the "image_variants" field.
+	ImageVariants []schematype.ImageVariant `json:"image_variants,omitempty"`
 	// PublishedAt holds the value of the "published_at" field.
-	PublishedAt  time.Time `json:"published_at,omitempty"`
-	selectValues sql.SelectValues
+	PublishedAt time.Time `json:"published_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status blogpost.Status `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlogPostQuery when eager-loading is set.
+	Edges          BlogPostEdges `json:"edges"`
+	author_posts   *int
+	category_posts *int
+	media_posts    *int
+	user_posts     *int
+	selectValues   sql.SelectValues
+}
+
+// BlogPostEdges holds the relations/edges for other nodes in the graph.
+type BlogPostEdges struct {
+	// Author holds the value of the author edge.
+	Author *User `json:"author,omitempty"`
+	// Byline holds the value of the byline edge.
+	Byline *Author `json:"byline,omitempty"`
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
+	// SlugAliases holds the value of the slug_aliases edge.
+	SlugAliases []*SlugAlias `json:"slug_aliases,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogPostEdges) AuthorOrErr() (*User, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// BylineOrErr returns the Byline value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogPostEdges) BylineOrErr() (*Author, error) {
+	if e.Byline != nil {
+		return e.Byline, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: author.Label}
+	}
+	return nil, &NotLoadedError{edge: "byline"}
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogPostEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogPostEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogPostEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogPostEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[5] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// SlugAliasesOrErr returns the SlugAliases value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogPostEdges) SlugAliasesOrErr() ([]*SlugAlias, error) {
+	if e.loadedTypes[6] {
+		return e.SlugAliases, nil
+	}
+	return nil, &NotLoadedError{edge: "slug_aliases"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -41,12 +164,24 @@ func (*BlogPost) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case blogpost.FieldID:
+		case blogpost.FieldToc, blogpost.FieldImageVariants:
+			values[i] = new([]byte)
+		case blogpost.FieldExcerptGenerated:
+			values[i] = new(sql.NullBool)
+		case blogpost.FieldID, blogpost.FieldWordCount, blogpost.FieldReadingTime:
 			values[i] = new(sql.NullInt64)
-		case blogpost.FieldTitle, blogpost.FieldSlug, blogpost.FieldContent, blogpost.FieldExcerpt, blogpost.FieldImage:
+		case blogpost.FieldTitle, blogpost.FieldSlug, blogpost.FieldContent, blogpost.FieldContentFormat, blogpost.FieldContentHTML, blogpost.FieldExcerpt, blogpost.FieldImage, blogpost.FieldStatus:
 			values[i] = new(sql.NullString)
-		case blogpost.FieldCreateTime, blogpost.FieldUpdateTime, blogpost.FieldPublishedAt:
+		case blogpost.FieldCreateTime, blogpost.FieldUpdateTime, blogpost.FieldDeletedAt, blogpost.FieldPublishedAt:
 			values[i] = new(sql.NullTime)
+		case blogpost.ForeignKeys[0]: // author_posts
+			values[i] = new(sql.NullInt64)
+		case blogpost.ForeignKeys[1]: // category_posts
+			values[i] = new(sql.NullInt64)
+		case blogpost.ForeignKeys[2]: // media_posts
+			values[i] = new(sql.NullInt64)
+		case blogpost.ForeignKeys[3]: // user_posts
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -80,6 +215,13 @@ func (bp *BlogPost) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				bp.UpdateTime = value.Time
 			}
+		case blogpost.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				bp.DeletedAt = new(time.Time)
+				*bp.DeletedAt = value.Time
+			}
 		case blogpost.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -98,24 +240,104 @@ func (bp *BlogPost) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				bp.Content = value.String
 			}
+		case blogpost.FieldContentFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_format", values[i])
+			} else if value.Valid {
+				bp.ContentFormat = blogpost.ContentFormat(value.String)
+			}
+		case blogpost.FieldContentHTML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_html", values[i])
+			} else if value.Valid {
+				bp.ContentHTML = value.String
+			}
+		case blogpost.FieldToc:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field toc", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bp.Toc); err != nil {
+					return fmt.Errorf("unmarshal field toc: %w", err)
+				}
+			}
+		case blogpost.FieldWordCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field word_count", values[i])
+			} else if value.Valid {
+				bp.WordCount = int(value.Int64)
+			}
+		case blogpost.FieldReadingTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reading_time", values[i])
+			} else if value.Valid {
+				bp.ReadingTime = int(value.Int64)
+			}
 		case blogpost.FieldExcerpt:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field excerpt", values[i])
 			} else if value.Valid {
 				bp.Excerpt = value.String
 			}
+		case blogpost.FieldExcerptGenerated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field excerpt_generated", values[i])
+			} else if value.Valid {
+				bp.ExcerptGenerated = value.Bool
+			}
 		case blogpost.FieldImage:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field image", values[i])
 			} else if value.Valid {
 				bp.Image = value.String
 			}
+		case blogpost.FieldImageVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field image_variants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bp.ImageVariants); err != nil {
+					return fmt.Errorf("unmarshal field image_variants: %w", err)
+				}
+			}
 		case blogpost.FieldPublishedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field published_at", values[i])
 			} else if value.Valid {
 				bp.PublishedAt = value.Time
 			}
+		case blogpost.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				bp.Status = blogpost.Status(value.String)
+			}
+		case blogpost.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field author_posts", value)
+			} else if value.Valid {
+				bp.author_posts = new(int)
+				*bp.author_posts = int(value.Int64)
+			}
+		case blogpost.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field category_posts", value)
+			} else if value.Valid {
+				bp.category_posts = new(int)
+				*bp.category_posts = int(value.Int64)
+			}
+		case blogpost.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field media_posts", value)
+			} else if value.Valid {
+				bp.media_posts = new(int)
+				*bp.media_posts = int(value.Int64)
+			}
+		case blogpost.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_posts", value)
+			} else if value.Valid {
+				bp.user_posts = new(int)
+				*bp.user_posts = int(value.Int64)
+			}
 		default:
 			bp.selectValues.Set(columns[i], values[i])
 		}
@@ -129,6 +351,41 @@ func (bp *BlogPost) Value(name string) (ent.Value, error) {
 	return bp.selectValues.Get(name)
 }
 
+// QueryAuthor queries the "author" edge of the BlogPost entity.
+func (bp *BlogPost) QueryAuthor() *UserQuery {
+	return NewBlogPostClient(bp.config).QueryAuthor(bp)
+}
+
+// QueryByline queries the "byline" edge of the BlogPost entity.
+func (bp *BlogPost) QueryByline() *AuthorQuery {
+	return NewBlogPostClient(bp.config).QueryByline(bp)
+}
+
+// QueryCategory queries the "category" edge of the BlogPost entity.
+func (bp *BlogPost) QueryCategory() *CategoryQuery {
+	return NewBlogPostClient(bp.config).QueryCategory(bp)
+}
+
+// QueryTags queries the "tags" edge of the BlogPost entity.
+func (bp *BlogPost) QueryTags() *TagQuery {
+	return NewBlogPostClient(bp.config).QueryTags(bp)
+}
+
+// QueryMedia queries the "media" edge of the BlogPost entity.
+func (bp *BlogPost) QueryMedia() *MediaQuery {
+	return NewBlogPostClient(bp.config).QueryMedia(bp)
+}
+
+// QueryRevisions queries the "revisions" edge of the BlogPost entity.
+func (bp *BlogPost) QueryRevisions() *PostRevisionQuery {
+	return NewBlogPostClient(bp.config).QueryRevisions(bp)
+}
+
+// QuerySlugAliases queries the "slug_aliases" edge of the BlogPost entity.
+func (bp *BlogPost) QuerySlugAliases() *SlugAliasQuery {
+	return NewBlogPostClient(bp.config).QuerySlugAliases(bp)
+}
+
 // Update returns a builder for updating this BlogPost.
 // Note that you need to call BlogPost.Unwrap() before calling this method if this BlogPost
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -158,6 +415,11 @@ func (bp *BlogPost) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(bp.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := bp.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(bp.Title)
 	builder.WriteString(", ")
@@ -167,14 +429,38 @@ func (bp *BlogPost) String() string {
 	builder.WriteString("content=")
 	builder.WriteString(bp.Content)
 	builder.WriteString(", ")
+	builder.WriteString("content_format=")
+	builder.WriteString(fmt.Sprintf("%v", bp.ContentFormat))
+	builder.WriteString(", ")
+	builder.WriteString("content_html=")
+	builder.WriteString(bp.ContentHTML)
+	builder.WriteString(", ")
+	builder.WriteString("toc=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Toc))
+	builder.WriteString(", ")
+	builder.WriteString("word_count=")
+	builder.WriteString(fmt.Sprintf("%v", bp.WordCount))
+	builder.WriteString(", ")
+	builder.WriteString("reading_time=")
+	builder.WriteString(fmt.Sprintf("%v", bp.ReadingTime))
+	builder.WriteString(", ")
 	builder.WriteString("excerpt=")
 	builder.WriteString(bp.Excerpt)
 	builder.WriteString(", ")
+	builder.WriteString("excerpt_generated=")
+	builder.WriteString(fmt.Sprintf("%v", bp.ExcerptGenerated))
+	builder.WriteString(", ")
 	builder.WriteString("image=")
 	builder.WriteString(bp.Image)
 	builder.WriteString(", ")
+	builder.WriteString("image_variants=")
+	builder.WriteString(fmt.Sprintf("%v", bp.ImageVariants))
+	builder.WriteString(", ")
 	builder.WriteString("published_at=")
 	builder.WriteString(bp.PublishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Status))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,9 +3,12 @@
 package blogpost
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -17,20 +20,99 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
 	// FieldSlug holds the string denoting the slug field in the database.
 	FieldSlug = "slug"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
+	// FieldContentFormat holds the string denoting the content_format field in the database.
+	FieldContentFormat = "content_format"
+	// FieldContentHTML holds the string denoting the content_html field in the database.
+	FieldContentHTML = "content_html"
+	// FieldToc holds the string denoting the toc field in the database.
+	FieldToc = "toc"
+	// FieldWordCount holds the string denoting the word_count field in the database.
+	FieldWordCount = "word_count"
+	// FieldReadingTime holds the string denoting the reading_time field in the database.
+	FieldReadingTime = "reading_time"
 	// FieldExcerpt holds the string denoting the excerpt field in the database.
 	FieldExcerpt = "excerpt"
+	// FieldExcerptGenerated holds the string denoting the excerpt_generated field in the database.
+	FieldExcerptGenerated = "excerpt_generated"
 	// FieldImage holds the string denoting the image field in the database.
 	FieldImage = "image"
+	// FieldImageVariants holds the string denoting the image_variants field in the database.
+	FieldImageVariants = "image_variants"
 	// FieldPublishedAt holds the string denoting the published_at field in the database.
 	FieldPublishedAt = "published_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// EdgeAuthor holds the string denoting the author edge name in mutations.
+	EdgeAuthor = "author"
+	// EdgeByline holds the string denoting the byline edge name in mutations.
+	EdgeByline = "byline"
+	// EdgeCategory holds the string denoting the category edge name in mutations.
+	EdgeCategory = "category"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeMedia holds the string denoting the media edge name in mutations.
+	EdgeMedia = "media"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeSlugAliases holds the string denoting the slug_aliases edge name in mutations.
+	EdgeSlugAliases = "slug_aliases"
 	// Table holds the table name of the blogpost in the database.
 	Table = "blog_posts"
+	// AuthorTable is the table that holds the author relation/edge.
+	AuthorTable = "blog_posts"
+	// AuthorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	AuthorInverseTable = "users"
+	// AuthorColumn is the table column denoting the author relation/edge.
+	AuthorColumn = "user_posts"
+	// BylineTable is the table that holds the byline relation/edge.
+	BylineTable = "blog_posts"
+	// BylineInverseTable is the table name for the Author entity.
+	// It exists in this package in order to avoid circular dependency with the "author" package.
+	BylineInverseTable = "authors"
+	// BylineColumn is the table column denoting the byline relation/edge.
+	BylineColumn = "author_posts"
+	// CategoryTable is the table that holds the category relation/edge.
+	CategoryTable = "blog_posts"
+	// CategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoryInverseTable = "categories"
+	// CategoryColumn is the table column denoting the category relation/edge.
+	CategoryColumn = "category_posts"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_posts"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// MediaTable is the table that holds the media relation/edge.
+	MediaTable = "blog_posts"
+	// MediaInverseTable is the table name for the Media entity.
+	// It exists in this package in order to avoid circular dependency with the "media" package.
+	MediaInverseTable = "media"
+	// MediaColumn is the table column denoting the media relation/edge.
+	MediaColumn = "media_posts"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "post_revisions"
+	// RevisionsInverseTable is the table name for the PostRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "postrevision" package.
+	RevisionsInverseTable = "post_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "blog_post_revisions"
+	// SlugAliasesTable is the table that holds the slug_aliases relation/edge.
+	SlugAliasesTable = "slug_alias"
+	// SlugAliasesInverseTable is the table name for the SlugAlias entity.
+	// It exists in this package in order to avoid circular dependency with the "slugalias" package.
+	SlugAliasesInverseTable = "slug_alias"
+	// SlugAliasesColumn is the table column denoting the slug_aliases relation/edge.
+	SlugAliasesColumn = "blog_post_slug_aliases"
 )
 
 // Columns holds all SQL columns for blogpost fields.
@@ -38,14 +120,38 @@ var Columns = []string{
 	FieldID,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldDeletedAt,
 	FieldTitle,
 	FieldSlug,
 	FieldContent,
+	FieldContentFormat,
+	FieldContentHTML,
+	FieldToc,
+	FieldWordCount,
+	FieldReadingTime,
 	FieldExcerpt,
+	FieldExcerptGenerated,
 	FieldImage,
+	FieldImageVariants,
 	FieldPublishedAt,
+	FieldStatus,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the "blog_posts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"author_posts",
+	"category_posts",
+	"media_posts",
+	"user_posts",
+}
+
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "blog_post_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -53,10 +159,21 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/AdongoJr2/technoprise-backend/ent/runtime"
 var (
+	Interceptors [1]ent.Interceptor
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -69,10 +186,75 @@ var (
 	SlugValidator func(string) error
 	// ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	ContentValidator func(string) error
+	// DefaultWordCount holds the default value on creation for the "word_count" field.
+	DefaultWordCount int
+	// WordCountValidator is a validator for the "word_count" field. It is called by the builders before save.
+	WordCountValidator func(int) error
+	// DefaultReadingTime holds the default value on creation for the "reading_time" field.
+	DefaultReadingTime int
+	// ReadingTimeValidator is a validator for the "reading_time" field. It is called by the builders before save.
+	ReadingTimeValidator func(int) error
 	// ExcerptValidator is a validator for the "excerpt" field. It is called by the builders before save.
 	ExcerptValidator func(string) error
+	// DefaultExcerptGenerated holds the default value on creation for the "excerpt_generated" field.
+	DefaultExcerptGenerated bool
+)
+
+// ContentFormat defines the type for the "content_format" enum field.
+type ContentFormat string
+
+// ContentFormatMarkdown is the default value of the ContentFormat enum.
+const DefaultContentFormat = ContentFormatMarkdown
+
+// ContentFormat values.
+const (
+	ContentFormatMarkdown  ContentFormat = "markdown"
+	ContentFormatHTML      ContentFormat = "html"
+	ContentFormatPlaintext ContentFormat = "plaintext"
+)
+
+func (cf ContentFormat) String() string {
+	return string(cf)
+}
+
+// ContentFormatValidator is a validator for the "content_format" field enum values. It is called by the builders before save.
+func ContentFormatValidator(cf ContentFormat) error {
+	switch cf {
+	case ContentFormatMarkdown, ContentFormatHTML, ContentFormatPlaintext:
+		return nil
+	default:
+		return fmt.Errorf("blogpost: invalid enum value for content_format field: %q", cf)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPublished is the default value of the Status enum.
+const DefaultStatus = StatusPublished
+
+// Status values.
+const (
+	StatusDraft     Status = "draft"
+	StatusScheduled Status = "scheduled"
+	StatusPublished Status = "published"
+	StatusArchived  Status = "archived"
 )
 
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusDraft, StatusScheduled, StatusPublished, StatusArchived:
+		return nil
+	default:
+		return fmt.Errorf("blogpost: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the BlogPost queries.
 type OrderOption func(*sql.Selector)
 
@@ -91,6 +273,11 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
@@ -106,11 +293,36 @@ func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
 }
 
+// ByContentFormat orders the results by the content_format field.
+func ByContentFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentFormat, opts...).ToFunc()
+}
+
+// ByContentHTML orders the results by the content_html field.
+func ByContentHTML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHTML, opts...).ToFunc()
+}
+
+// ByWordCount orders the results by the word_count field.
+func ByWordCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWordCount, opts...).ToFunc()
+}
+
+// ByReadingTime orders the results by the reading_time field.
+func ByReadingTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadingTime, opts...).ToFunc()
+}
+
 // ByExcerpt orders the results by the excerpt field.
 func ByExcerpt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExcerpt, opts...).ToFunc()
 }
 
+// ByExcerptGenerated orders the results by the excerpt_generated field.
+func ByExcerptGenerated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExcerptGenerated, opts...).ToFunc()
+}
+
 // ByImage orders the results by the image field.
 func ByImage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImage, opts...).ToFunc()
@@ -120,3 +332,127 @@ func ByImage(opts ...sql.OrderTermOption) OrderOption {
 func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
 }
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAuthorField orders the results by author field.
+func ByAuthorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuthorStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBylineField orders the results by byline field.
+func ByBylineField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBylineStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCategoryField orders the results by category field.
+func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMediaField orders the results by media field.
+func ByMediaField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMediaStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySlugAliasesCount orders the results by slug_aliases count.
+func BySlugAliasesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSlugAliasesStep(), opts...)
+	}
+}
+
+// BySlugAliases orders the results by slug_aliases terms.
+func BySlugAliases(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSlugAliasesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAuthorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuthorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AuthorTable, AuthorColumn),
+	)
+}
+func newBylineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BylineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BylineTable, BylineColumn),
+	)
+}
+func newCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newMediaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MediaInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MediaTable, MediaColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newSlugAliasesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SlugAliasesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SlugAliasesTable, SlugAliasesColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
 )
 
@@ -64,6 +65,11 @@ func UpdateTime(v time.Time) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldEQ(FieldTitle, v))
@@ -79,11 +85,31 @@ func Content(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldEQ(FieldContent, v))
 }
 
+// ContentHTML applies equality check predicate on the "content_html" field. It's identical to ContentHTMLEQ.
+func ContentHTML(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldContentHTML, v))
+}
+
+// WordCount applies equality check predicate on the "word_count" field. It's identical to WordCountEQ.
+func WordCount(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldWordCount, v))
+}
+
+// ReadingTime applies equality check predicate on the "reading_time" field. It's identical to ReadingTimeEQ.
+func ReadingTime(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldReadingTime, v))
+}
+
 // Excerpt applies equality check predicate on the "excerpt" field. It's identical to ExcerptEQ.
 func Excerpt(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldEQ(FieldExcerpt, v))
 }
 
+// ExcerptGenerated applies equality check predicate on the "excerpt_generated" field. It's identical to ExcerptGeneratedEQ.
+func ExcerptGenerated(v bool) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldExcerptGenerated, v))
+}
+
 // Image applies equality check predicate on the "image" field. It's identical to ImageEQ.
 func Image(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldEQ(FieldImage, v))
@@ -174,6 +200,56 @@ func UpdateTimeLTE(v time.Time) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldEQ(FieldTitle, v))
@@ -369,6 +445,191 @@ func ContentContainsFold(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldContainsFold(FieldContent, v))
 }
 
+// ContentFormatEQ applies the EQ predicate on the "content_format" field.
+func ContentFormatEQ(v ContentFormat) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldContentFormat, v))
+}
+
+// ContentFormatNEQ applies the NEQ predicate on the "content_format" field.
+func ContentFormatNEQ(v ContentFormat) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNEQ(FieldContentFormat, v))
+}
+
+// ContentFormatIn applies the In predicate on the "content_format" field.
+func ContentFormatIn(vs ...ContentFormat) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIn(FieldContentFormat, vs...))
+}
+
+// ContentFormatNotIn applies the NotIn predicate on the "content_format" field.
+func ContentFormatNotIn(vs ...ContentFormat) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotIn(FieldContentFormat, vs...))
+}
+
+// ContentHTMLEQ applies the EQ predicate on the "content_html" field.
+func ContentHTMLEQ(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldContentHTML, v))
+}
+
+// ContentHTMLNEQ applies the NEQ predicate on the "content_html" field.
+func ContentHTMLNEQ(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNEQ(FieldContentHTML, v))
+}
+
+// ContentHTMLIn applies the In predicate on the "content_html" field.
+func ContentHTMLIn(vs ...string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIn(FieldContentHTML, vs...))
+}
+
+// ContentHTMLNotIn applies the NotIn predicate on the "content_html" field.
+func ContentHTMLNotIn(vs ...string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotIn(FieldContentHTML, vs...))
+}
+
+// ContentHTMLGT applies the GT predicate on the "content_html" field.
+func ContentHTMLGT(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldGT(FieldContentHTML, v))
+}
+
+// ContentHTMLGTE applies the GTE predicate on the "content_html" field.
+func ContentHTMLGTE(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldGTE(FieldContentHTML, v))
+}
+
+// ContentHTMLLT applies the LT predicate on the "content_html" field.
+func ContentHTMLLT(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldLT(FieldContentHTML, v))
+}
+
+// ContentHTMLLTE applies the LTE predicate on the "content_html" field.
+func ContentHTMLLTE(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldLTE(FieldContentHTML, v))
+}
+
+// ContentHTMLContains applies the Contains predicate on the "content_html" field.
+func ContentHTMLContains(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldContains(FieldContentHTML, v))
+}
+
+// ContentHTMLHasPrefix applies the HasPrefix predicate on the "content_html" field.
+func ContentHTMLHasPrefix(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldHasPrefix(FieldContentHTML, v))
+}
+
+// ContentHTMLHasSuffix applies the HasSuffix predicate on the "content_html" field.
+func ContentHTMLHasSuffix(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldHasSuffix(FieldContentHTML, v))
+}
+
+// ContentHTMLIsNil applies the IsNil predicate on the "content_html" field.
+func ContentHTMLIsNil() predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIsNull(FieldContentHTML))
+}
+
+// ContentHTMLNotNil applies the NotNil predicate on the "content_html" field.
+func ContentHTMLNotNil() predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotNull(FieldContentHTML))
+}
+
+// ContentHTMLEqualFold applies the EqualFold predicate on the "content_html" field.
+func ContentHTMLEqualFold(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEqualFold(FieldContentHTML, v))
+}
+
+// ContentHTMLContainsFold applies the ContainsFold predicate on the "content_html" field.
+func ContentHTMLContainsFold(v string) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldContainsFold(FieldContentHTML, v))
+}
+
+// TocIsNil applies the IsNil predicate on the "toc" field.
+func TocIsNil() predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIsNull(FieldToc))
+}
+
+// TocNotNil applies the NotNil predicate on the "toc" field.
+func TocNotNil() predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotNull(FieldToc))
+}
+
+// WordCountEQ applies the EQ predicate on the "word_count" field.
+func WordCountEQ(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldWordCount, v))
+}
+
+// WordCountNEQ applies the NEQ predicate on the "word_count" field.
+func WordCountNEQ(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNEQ(FieldWordCount, v))
+}
+
+// WordCountIn applies the In predicate on the "word_count" field.
+func WordCountIn(vs ...int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIn(FieldWordCount, vs...))
+}
+
+// WordCountNotIn applies the NotIn predicate on the "word_count" field.
+func WordCountNotIn(vs ...int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotIn(FieldWordCount, vs...))
+}
+
+// WordCountGT applies the GT predicate on the "word_count" field.
+func WordCountGT(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldGT(FieldWordCount, v))
+}
+
+// WordCountGTE applies the GTE predicate on the "word_count" field.
+func WordCountGTE(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldGTE(FieldWordCount, v))
+}
+
+// WordCountLT applies the LT predicate on the "word_count" field.
+func WordCountLT(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldLT(FieldWordCount, v))
+}
+
+// WordCountLTE applies the LTE predicate on the "word_count" field.
+func WordCountLTE(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldLTE(FieldWordCount, v))
+}
+
+// ReadingTimeEQ applies the EQ predicate on the "reading_time" field.
+func ReadingTimeEQ(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldReadingTime, v))
+}
+
+// ReadingTimeNEQ applies the NEQ predicate on the "reading_time" field.
+func ReadingTimeNEQ(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNEQ(FieldReadingTime, v))
+}
+
+// ReadingTimeIn applies the In predicate on the "reading_time" field.
+func ReadingTimeIn(vs ...int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIn(FieldReadingTime, vs...))
+}
+
+// ReadingTimeNotIn applies the NotIn predicate on the "reading_time" field.
+func ReadingTimeNotIn(vs ...int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotIn(FieldReadingTime, vs...))
+}
+
+// ReadingTimeGT applies the GT predicate on the "reading_time" field.
+func ReadingTimeGT(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldGT(FieldReadingTime, v))
+}
+
+// ReadingTimeGTE applies the GTE predicate on the "reading_time" field.
+func ReadingTimeGTE(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldGTE(FieldReadingTime, v))
+}
+
+// ReadingTimeLT applies the LT predicate on the "reading_time" field.
+func ReadingTimeLT(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldLT(FieldReadingTime, v))
+}
+
+// ReadingTimeLTE applies the LTE predicate on the "reading_time" field.
+func ReadingTimeLTE(v int) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldLTE(FieldReadingTime, v))
+}
+
 // ExcerptEQ applies the EQ predicate on the "excerpt" field.
 func ExcerptEQ(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldEQ(FieldExcerpt, v))
@@ -434,6 +695,16 @@ func ExcerptContainsFold(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldContainsFold(FieldExcerpt, v))
 }
 
+// ExcerptGeneratedEQ applies the EQ predicate on the "excerpt_generated" field.
+func ExcerptGeneratedEQ(v bool) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldExcerptGenerated, v))
+}
+
+// ExcerptGeneratedNEQ applies the NEQ predicate on the "excerpt_generated" field.
+func ExcerptGeneratedNEQ(v bool) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNEQ(FieldExcerptGenerated, v))
+}
+
 // ImageEQ applies the EQ predicate on the "image" field.
 func ImageEQ(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldEQ(FieldImage, v))
@@ -509,6 +780,16 @@ func ImageContainsFold(v string) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldContainsFold(FieldImage, v))
 }
 
+// ImageVariantsIsNil applies the IsNil predicate on the "image_variants" field.
+func ImageVariantsIsNil() predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIsNull(FieldImageVariants))
+}
+
+// ImageVariantsNotNil applies the NotNil predicate on the "image_variants" field.
+func ImageVariantsNotNil() predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotNull(FieldImageVariants))
+}
+
 // PublishedAtEQ applies the EQ predicate on the "published_at" field.
 func PublishedAtEQ(v time.Time) predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldEQ(FieldPublishedAt, v))
@@ -559,6 +840,187 @@ func PublishedAtNotNil() predicate.BlogPost {
 	return predicate.BlogPost(sql.FieldNotNull(FieldPublishedAt))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.BlogPost {
+	return predicate.BlogPost(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HasAuthor applies the HasEdge predicate on the "author" edge.
+func HasAuthor() predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AuthorTable, AuthorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthorWith applies the HasEdge predicate on the "author" edge with a given conditions (other predicates).
+func HasAuthorWith(preds ...predicate.User) predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := newAuthorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasByline applies the HasEdge predicate on the "byline" edge.
+func HasByline() predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BylineTable, BylineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBylineWith applies the HasEdge predicate on the "byline" edge with a given conditions (other predicates).
+func HasBylineWith(preds ...predicate.Author) predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := newBylineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMedia applies the HasEdge predicate on the "media" edge.
+func HasMedia() predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MediaTable, MediaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMediaWith applies the HasEdge predicate on the "media" edge with a given conditions (other predicates).
+func HasMediaWith(preds ...predicate.Media) predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := newMediaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.PostRevision) predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSlugAliases applies the HasEdge predicate on the "slug_aliases" edge.
+func HasSlugAliases() predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SlugAliasesTable, SlugAliasesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSlugAliasesWith applies the HasEdge predicate on the "slug_aliases" edge with a given conditions (other predicates).
+func HasSlugAliasesWith(preds ...predicate.SlugAlias) predicate.BlogPost {
+	return predicate.BlogPost(func(s *sql.Selector) {
+		step := newSlugAliasesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.BlogPost) predicate.BlogPost {
 	return predicate.BlogPost(sql.AndPredicates(predicates...))
@@ -10,7 +10,15 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
 	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/category"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/postrevision"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+	"github.com/AdongoJr2/technoprise-backend/ent/tag"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
 )
 
 // BlogPostCreate is the builder for creating a BlogPost entity.
@@ -48,6 +56,20 @@ func (bpc *BlogPostCreate) SetNillableUpdateTime(t *time.Time) *BlogPostCreate {
 	return bpc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (bpc *BlogPostCreate) SetDeletedAt(t time.Time) *BlogPostCreate {
+	bpc.mutation.SetDeletedAt(t)
+	return bpc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableDeletedAt(t *time.Time) *BlogPostCreate {
+	if t != nil {
+		bpc.SetDeletedAt(*t)
+	}
+	return bpc
+}
+
 // SetTitle sets the "title" field.
 func (bpc *BlogPostCreate) SetTitle(s string) *BlogPostCreate {
 	bpc.mutation.SetTitle(s)
@@ -66,12 +88,88 @@ func (bpc *BlogPostCreate) SetContent(s string) *BlogPostCreate {
 	return bpc
 }
 
+// SetContentFormat sets the "content_format" field.
+func (bpc *BlogPostCreate) SetContentFormat(bf blogpost.ContentFormat) *BlogPostCreate {
+	bpc.mutation.SetContentFormat(bf)
+	return bpc
+}
+
+// SetNillableContentFormat sets the "content_format" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableContentFormat(bf *blogpost.ContentFormat) *BlogPostCreate {
+	if bf != nil {
+		bpc.SetContentFormat(*bf)
+	}
+	return bpc
+}
+
+// SetContentHTML sets the "content_html" field.
+func (bpc *BlogPostCreate) SetContentHTML(s string) *BlogPostCreate {
+	bpc.mutation.SetContentHTML(s)
+	return bpc
+}
+
+// SetNillableContentHTML sets the "content_html" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableContentHTML(s *string) *BlogPostCreate {
+	if s != nil {
+		bpc.SetContentHTML(*s)
+	}
+	return bpc
+}
+
+// SetToc sets the "toc" field.
+func (bpc *BlogPostCreate) SetToc(se []schematype.TOCEntry) *BlogPostCreate {
+	bpc.mutation.SetToc(se)
+	return bpc
+}
+
+// SetWordCount sets the "word_count" field.
+func (bpc *BlogPostCreate) SetWordCount(i int) *BlogPostCreate {
+	bpc.mutation.SetWordCount(i)
+	return bpc
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableWordCount(i *int) *BlogPostCreate {
+	if i != nil {
+		bpc.SetWordCount(*i)
+	}
+	return bpc
+}
+
+// SetReadingTime sets the "reading_time" field.
+func (bpc *BlogPostCreate) SetReadingTime(i int) *BlogPostCreate {
+	bpc.mutation.SetReadingTime(i)
+	return bpc
+}
+
+// SetNillableReadingTime sets the "reading_time" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableReadingTime(i *int) *BlogPostCreate {
+	if i != nil {
+		bpc.SetReadingTime(*i)
+	}
+	return bpc
+}
+
 // SetExcerpt sets the "excerpt" field.
 func (bpc *BlogPostCreate) SetExcerpt(s string) *BlogPostCreate {
 	bpc.mutation.SetExcerpt(s)
 	return bpc
 }
 
+// SetExcerptGenerated sets the "excerpt_generated" field.
+func (bpc *BlogPostCreate) SetExcerptGenerated(b bool) *BlogPostCreate {
+	bpc.mutation.SetExcerptGenerated(b)
+	return bpc
+}
+
+// SetNillableExcerptGenerated sets the "excerpt_generated" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableExcerptGenerated(b *bool) *BlogPostCreate {
+	if b != nil {
+		bpc.SetExcerptGenerated(*b)
+	}
+	return bpc
+}
+
 // SetImage sets the "image" field.
 func (bpc *BlogPostCreate) SetImage(s string) *BlogPostCreate {
 	bpc.mutation.SetImage(s)
@@ -86,6 +184,12 @@ func (bpc *BlogPostCreate) SetNillableImage(s *string) *BlogPostCreate {
 	return bpc
 }
 
+// SetImageVariants sets the "image_variants" field.
+func (bpc *BlogPostCreate) SetImageVariants(sv []schematype.ImageVariant) *BlogPostCreate {
+	bpc.mutation.SetImageVariants(sv)
+	return bpc
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (bpc *BlogPostCreate) SetPublishedAt(t time.Time) *BlogPostCreate {
 	bpc.mutation.SetPublishedAt(t)
@@ -100,6 +204,141 @@ func (bpc *BlogPostCreate) SetNillablePublishedAt(t *time.Time) *BlogPostCreate
 	return bpc
 }
 
+// SetStatus sets the "status" field.
+func (bpc *BlogPostCreate) SetStatus(b blogpost.Status) *BlogPostCreate {
+	bpc.mutation.SetStatus(b)
+	return bpc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableStatus(b *blogpost.Status) *BlogPostCreate {
+	if b != nil {
+		bpc.SetStatus(*b)
+	}
+	return bpc
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (bpc *BlogPostCreate) SetAuthorID(id int) *BlogPostCreate {
+	bpc.mutation.SetAuthorID(id)
+	return bpc
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableAuthorID(id *int) *BlogPostCreate {
+	if id != nil {
+		bpc = bpc.SetAuthorID(*id)
+	}
+	return bpc
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (bpc *BlogPostCreate) SetAuthor(u *User) *BlogPostCreate {
+	return bpc.SetAuthorID(u.ID)
+}
+
+// SetBylineID sets the "byline" edge to the Author entity by ID.
+func (bpc *BlogPostCreate) SetBylineID(id int) *BlogPostCreate {
+	bpc.mutation.SetBylineID(id)
+	return bpc
+}
+
+// SetNillableBylineID sets the "byline" edge to the Author entity by ID if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableBylineID(id *int) *BlogPostCreate {
+	if id != nil {
+		bpc = bpc.SetBylineID(*id)
+	}
+	return bpc
+}
+
+// SetByline sets the "byline" edge to the Author entity.
+func (bpc *BlogPostCreate) SetByline(a *Author) *BlogPostCreate {
+	return bpc.SetBylineID(a.ID)
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (bpc *BlogPostCreate) SetCategoryID(id int) *BlogPostCreate {
+	bpc.mutation.SetCategoryID(id)
+	return bpc
+}
+
+// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableCategoryID(id *int) *BlogPostCreate {
+	if id != nil {
+		bpc = bpc.SetCategoryID(*id)
+	}
+	return bpc
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (bpc *BlogPostCreate) SetCategory(c *Category) *BlogPostCreate {
+	return bpc.SetCategoryID(c.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (bpc *BlogPostCreate) AddTagIDs(ids ...int) *BlogPostCreate {
+	bpc.mutation.AddTagIDs(ids...)
+	return bpc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (bpc *BlogPostCreate) AddTags(t ...*Tag) *BlogPostCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bpc.AddTagIDs(ids...)
+}
+
+// SetMediaID sets the "media" edge to the Media entity by ID.
+func (bpc *BlogPostCreate) SetMediaID(id int) *BlogPostCreate {
+	bpc.mutation.SetMediaID(id)
+	return bpc
+}
+
+// SetNillableMediaID sets the "media" edge to the Media entity by ID if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableMediaID(id *int) *BlogPostCreate {
+	if id != nil {
+		bpc = bpc.SetMediaID(*id)
+	}
+	return bpc
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (bpc *BlogPostCreate) SetMedia(m *Media) *BlogPostCreate {
+	return bpc.SetMediaID(m.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (bpc *BlogPostCreate) AddRevisionIDs(ids ...int) *BlogPostCreate {
+	bpc.mutation.AddRevisionIDs(ids...)
+	return bpc
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (bpc *BlogPostCreate) AddRevisions(p ...*PostRevision) *BlogPostCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bpc.AddRevisionIDs(ids...)
+}
+
+// AddSlugAliasIDs adds the "slug_aliases" edge to the SlugAlias entity by IDs.
+func (bpc *BlogPostCreate) AddSlugAliasIDs(ids ...int) *BlogPostCreate {
+	bpc.mutation.AddSlugAliasIDs(ids...)
+	return bpc
+}
+
+// AddSlugAliases adds the "slug_aliases" edges to the SlugAlias entity.
+func (bpc *BlogPostCreate) AddSlugAliases(s ...*SlugAlias) *BlogPostCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bpc.AddSlugAliasIDs(ids...)
+}
+
 // Mutation returns the BlogPostMutation object of the builder.
 func (bpc *BlogPostCreate) Mutation() *BlogPostMutation {
 	return bpc.mutation
@@ -143,6 +382,26 @@ func (bpc *BlogPostCreate) defaults() {
 		v := blogpost.DefaultUpdateTime()
 		bpc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := bpc.mutation.ContentFormat(); !ok {
+		v := blogpost.DefaultContentFormat
+		bpc.mutation.SetContentFormat(v)
+	}
+	if _, ok := bpc.mutation.WordCount(); !ok {
+		v := blogpost.DefaultWordCount
+		bpc.mutation.SetWordCount(v)
+	}
+	if _, ok := bpc.mutation.ReadingTime(); !ok {
+		v := blogpost.DefaultReadingTime
+		bpc.mutation.SetReadingTime(v)
+	}
+	if _, ok := bpc.mutation.ExcerptGenerated(); !ok {
+		v := blogpost.DefaultExcerptGenerated
+		bpc.mutation.SetExcerptGenerated(v)
+	}
+	if _, ok := bpc.mutation.Status(); !ok {
+		v := blogpost.DefaultStatus
+		bpc.mutation.SetStatus(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -177,6 +436,30 @@ func (bpc *BlogPostCreate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "BlogPost.content": %w`, err)}
 		}
 	}
+	if _, ok := bpc.mutation.ContentFormat(); !ok {
+		return &ValidationError{Name: "content_format", err: errors.New(`ent: missing required field "BlogPost.content_format"`)}
+	}
+	if v, ok := bpc.mutation.ContentFormat(); ok {
+		if err := blogpost.ContentFormatValidator(v); err != nil {
+			return &ValidationError{Name: "content_format", err: fmt.Errorf(`ent: validator failed for field "BlogPost.content_format": %w`, err)}
+		}
+	}
+	if _, ok := bpc.mutation.WordCount(); !ok {
+		return &ValidationError{Name: "word_count", err: errors.New(`ent: missing required field "BlogPost.word_count"`)}
+	}
+	if v, ok := bpc.mutation.WordCount(); ok {
+		if err := blogpost.WordCountValidator(v); err != nil {
+			return &ValidationError{Name: "word_count", err: fmt.Errorf(`ent: validator failed for field "BlogPost.word_count": %w`, err)}
+		}
+	}
+	if _, ok := bpc.mutation.ReadingTime(); !ok {
+		return &ValidationError{Name: "reading_time", err: errors.New(`ent: missing required field "BlogPost.reading_time"`)}
+	}
+	if v, ok := bpc.mutation.ReadingTime(); ok {
+		if err := blogpost.ReadingTimeValidator(v); err != nil {
+			return &ValidationError{Name: "reading_time", err: fmt.Errorf(`ent: validator failed for field "BlogPost.reading_time": %w`, err)}
+		}
+	}
 	if _, ok := bpc.mutation.Excerpt(); !ok {
 		return &ValidationError{Name: "excerpt", err: errors.New(`ent: missing required field "BlogPost.excerpt"`)}
 	}
@@ -185,6 +468,17 @@ func (bpc *BlogPostCreate) check() error {
 			return &ValidationError{Name: "excerpt", err: fmt.Errorf(`ent: validator failed for field "BlogPost.excerpt": %w`, err)}
 		}
 	}
+	if _, ok := bpc.mutation.ExcerptGenerated(); !ok {
+		return &ValidationError{Name: "excerpt_generated", err: errors.New(`ent: missing required field "BlogPost.excerpt_generated"`)}
+	}
+	if _, ok := bpc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BlogPost.status"`)}
+	}
+	if v, ok := bpc.mutation.Status(); ok {
+		if err := blogpost.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BlogPost.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -219,6 +513,10 @@ func (bpc *BlogPostCreate) createSpec() (*BlogPost, *sqlgraph.CreateSpec) {
 		_spec.SetField(blogpost.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := bpc.mutation.DeletedAt(); ok {
+		_spec.SetField(blogpost.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := bpc.mutation.Title(); ok {
 		_spec.SetField(blogpost.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -231,18 +529,166 @@ func (bpc *BlogPostCreate) createSpec() (*BlogPost, *sqlgraph.CreateSpec) {
 		_spec.SetField(blogpost.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := bpc.mutation.ContentFormat(); ok {
+		_spec.SetField(blogpost.FieldContentFormat, field.TypeEnum, value)
+		_node.ContentFormat = value
+	}
+	if value, ok := bpc.mutation.ContentHTML(); ok {
+		_spec.SetField(blogpost.FieldContentHTML, field.TypeString, value)
+		_node.ContentHTML = value
+	}
+	if value, ok := bpc.mutation.Toc(); ok {
+		_spec.SetField(blogpost.FieldToc, field.TypeJSON, value)
+		_node.Toc = value
+	}
+	if value, ok := bpc.mutation.WordCount(); ok {
+		_spec.SetField(blogpost.FieldWordCount, field.TypeInt, value)
+		_node.WordCount = value
+	}
+	if value, ok := bpc.mutation.ReadingTime(); ok {
+		_spec.SetField(blogpost.FieldReadingTime, field.TypeInt, value)
+		_node.ReadingTime = value
+	}
 	if value, ok := bpc.mutation.Excerpt(); ok {
 		_spec.SetField(blogpost.FieldExcerpt, field.TypeString, value)
 		_node.Excerpt = value
 	}
+	if value, ok := bpc.mutation.ExcerptGenerated(); ok {
+		_spec.SetField(blogpost.FieldExcerptGenerated, field.TypeBool, value)
+		_node.ExcerptGenerated = value
+	}
 	if value, ok := bpc.mutation.Image(); ok {
 		_spec.SetField(blogpost.FieldImage, field.TypeString, value)
 		_node.Image = value
 	}
+	if value, ok := bpc.mutation.ImageVariants(); ok {
+		_spec.SetField(blogpost.FieldImageVariants, field.TypeJSON, value)
+		_node.ImageVariants = value
+	}
 	if value, ok := bpc.mutation.PublishedAt(); ok {
 		_spec.SetField(blogpost.FieldPublishedAt, field.TypeTime, value)
 		_node.PublishedAt = value
 	}
+	if value, ok := bpc.mutation.Status(); ok {
+		_spec.SetField(blogpost.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := bpc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_posts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bpc.mutation.BylineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.BylineTable,
+			Columns: []string{blogpost.BylineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(author.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.author_posts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bpc.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.CategoryTable,
+			Columns: []string{blogpost.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.category_posts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bpc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogpost.TagsTable,
+			Columns: blogpost.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bpc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.MediaTable,
+			Columns: []string{blogpost.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.media_posts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bpc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.RevisionsTable,
+			Columns: []string{blogpost.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bpc.mutation.SlugAliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.SlugAliasesTable,
+			Columns: []string{blogpost.SlugAliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,17 +12,33 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
 	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/category"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/postrevision"
 	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+	"github.com/AdongoJr2/technoprise-backend/ent/tag"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
 )
 
 // BlogPostQuery is the builder for querying BlogPost entities.
 type BlogPostQuery struct {
 	config
-	ctx        *QueryContext
-	order      []blogpost.OrderOption
-	inters     []Interceptor
-	predicates []predicate.BlogPost
+	ctx             *QueryContext
+	order           []blogpost.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.BlogPost
+	withAuthor      *UserQuery
+	withByline      *AuthorQuery
+	withCategory    *CategoryQuery
+	withTags        *TagQuery
+	withMedia       *MediaQuery
+	withRevisions   *PostRevisionQuery
+	withSlugAliases *SlugAliasQuery
+	withFKs         bool
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +75,160 @@ func (bpq *BlogPostQuery) Order(o ...blogpost.OrderOption) *BlogPostQuery {
 	return bpq
 }
 
+// QueryAuthor chains the current query on the "author" edge.
+func (bpq *BlogPostQuery) QueryAuthor() *UserQuery {
+	query := (&UserClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blogpost.Table, blogpost.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blogpost.AuthorTable, blogpost.AuthorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryByline chains the current query on the "byline" edge.
+func (bpq *BlogPostQuery) QueryByline() *AuthorQuery {
+	query := (&AuthorClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blogpost.Table, blogpost.FieldID, selector),
+			sqlgraph.To(author.Table, author.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blogpost.BylineTable, blogpost.BylineColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCategory chains the current query on the "category" edge.
+func (bpq *BlogPostQuery) QueryCategory() *CategoryQuery {
+	query := (&CategoryClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blogpost.Table, blogpost.FieldID, selector),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blogpost.CategoryTable, blogpost.CategoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (bpq *BlogPostQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blogpost.Table, blogpost.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, blogpost.TagsTable, blogpost.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMedia chains the current query on the "media" edge.
+func (bpq *BlogPostQuery) QueryMedia() *MediaQuery {
+	query := (&MediaClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blogpost.Table, blogpost.FieldID, selector),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blogpost.MediaTable, blogpost.MediaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (bpq *BlogPostQuery) QueryRevisions() *PostRevisionQuery {
+	query := (&PostRevisionClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blogpost.Table, blogpost.FieldID, selector),
+			sqlgraph.To(postrevision.Table, postrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blogpost.RevisionsTable, blogpost.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySlugAliases chains the current query on the "slug_aliases" edge.
+func (bpq *BlogPostQuery) QuerySlugAliases() *SlugAliasQuery {
+	query := (&SlugAliasClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blogpost.Table, blogpost.FieldID, selector),
+			sqlgraph.To(slugalias.Table, slugalias.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blogpost.SlugAliasesTable, blogpost.SlugAliasesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first BlogPost entity from the query.
 // Returns a *NotFoundError when no BlogPost was found.
 func (bpq *BlogPostQuery) First(ctx context.Context) (*BlogPost, error) {
@@ -245,15 +416,100 @@ func (bpq *BlogPostQuery) Clone() *BlogPostQuery {
 		return nil
 	}
 	return &BlogPostQuery{
-		config:     bpq.config,
-		ctx:        bpq.ctx.Clone(),
-		order:      append([]blogpost.OrderOption{}, bpq.order...),
-		inters:     append([]Interceptor{}, bpq.inters...),
-		predicates: append([]predicate.BlogPost{}, bpq.predicates...),
+		config:          bpq.config,
+		ctx:             bpq.ctx.Clone(),
+		order:           append([]blogpost.OrderOption{}, bpq.order...),
+		inters:          append([]Interceptor{}, bpq.inters...),
+		predicates:      append([]predicate.BlogPost{}, bpq.predicates...),
+		withAuthor:      bpq.withAuthor.Clone(),
+		withByline:      bpq.withByline.Clone(),
+		withCategory:    bpq.withCategory.Clone(),
+		withTags:        bpq.withTags.Clone(),
+		withMedia:       bpq.withMedia.Clone(),
+		withRevisions:   bpq.withRevisions.Clone(),
+		withSlugAliases: bpq.withSlugAliases.Clone(),
 		// clone intermediate query.
-		sql:  bpq.sql.Clone(),
-		path: bpq.path,
+		sql:       bpq.sql.Clone(),
+		path:      bpq.path,
+		modifiers: append([]func(*sql.Selector){}, bpq.modifiers...),
+	}
+}
+
+// WithAuthor tells the query-builder to eager-load the nodes that are connected to
+// the "author" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BlogPostQuery) WithAuthor(opts ...func(*UserQuery)) *BlogPostQuery {
+	query := (&UserClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withAuthor = query
+	return bpq
+}
+
+// WithByline tells the query-builder to eager-load the nodes that are connected to
+// the "byline" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BlogPostQuery) WithByline(opts ...func(*AuthorQuery)) *BlogPostQuery {
+	query := (&AuthorClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withByline = query
+	return bpq
+}
+
+// WithCategory tells the query-builder to eager-load the nodes that are connected to
+// the "category" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BlogPostQuery) WithCategory(opts ...func(*CategoryQuery)) *BlogPostQuery {
+	query := (&CategoryClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withCategory = query
+	return bpq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BlogPostQuery) WithTags(opts ...func(*TagQuery)) *BlogPostQuery {
+	query := (&TagClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withTags = query
+	return bpq
+}
+
+// WithMedia tells the query-builder to eager-load the nodes that are connected to
+// the "media" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BlogPostQuery) WithMedia(opts ...func(*MediaQuery)) *BlogPostQuery {
+	query := (&MediaClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
 	}
+	bpq.withMedia = query
+	return bpq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BlogPostQuery) WithRevisions(opts ...func(*PostRevisionQuery)) *BlogPostQuery {
+	query := (&PostRevisionClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withRevisions = query
+	return bpq
+}
+
+// WithSlugAliases tells the query-builder to eager-load the nodes that are connected to
+// the "slug_aliases" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BlogPostQuery) WithSlugAliases(opts ...func(*SlugAliasQuery)) *BlogPostQuery {
+	query := (&SlugAliasClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withSlugAliases = query
+	return bpq
 }
 
 // GroupBy is used to group vertices by one or more fields/columns.
@@ -332,17 +588,37 @@ func (bpq *BlogPostQuery) prepareQuery(ctx context.Context) error {
 
 func (bpq *BlogPostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BlogPost, error) {
 	var (
-		nodes = []*BlogPost{}
-		_spec = bpq.querySpec()
+		nodes       = []*BlogPost{}
+		withFKs     = bpq.withFKs
+		_spec       = bpq.querySpec()
+		loadedTypes = [7]bool{
+			bpq.withAuthor != nil,
+			bpq.withByline != nil,
+			bpq.withCategory != nil,
+			bpq.withTags != nil,
+			bpq.withMedia != nil,
+			bpq.withRevisions != nil,
+			bpq.withSlugAliases != nil,
+		}
 	)
+	if bpq.withAuthor != nil || bpq.withByline != nil || bpq.withCategory != nil || bpq.withMedia != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, blogpost.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*BlogPost).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &BlogPost{config: bpq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(bpq.modifiers) > 0 {
+		_spec.Modifiers = bpq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -352,11 +628,311 @@ func (bpq *BlogPostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Bl
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := bpq.withAuthor; query != nil {
+		if err := bpq.loadAuthor(ctx, query, nodes, nil,
+			func(n *BlogPost, e *User) { n.Edges.Author = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bpq.withByline; query != nil {
+		if err := bpq.loadByline(ctx, query, nodes, nil,
+			func(n *BlogPost, e *Author) { n.Edges.Byline = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bpq.withCategory; query != nil {
+		if err := bpq.loadCategory(ctx, query, nodes, nil,
+			func(n *BlogPost, e *Category) { n.Edges.Category = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bpq.withTags; query != nil {
+		if err := bpq.loadTags(ctx, query, nodes,
+			func(n *BlogPost) { n.Edges.Tags = []*Tag{} },
+			func(n *BlogPost, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bpq.withMedia; query != nil {
+		if err := bpq.loadMedia(ctx, query, nodes, nil,
+			func(n *BlogPost, e *Media) { n.Edges.Media = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bpq.withRevisions; query != nil {
+		if err := bpq.loadRevisions(ctx, query, nodes,
+			func(n *BlogPost) { n.Edges.Revisions = []*PostRevision{} },
+			func(n *BlogPost, e *PostRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bpq.withSlugAliases; query != nil {
+		if err := bpq.loadSlugAliases(ctx, query, nodes,
+			func(n *BlogPost) { n.Edges.SlugAliases = []*SlugAlias{} },
+			func(n *BlogPost, e *SlugAlias) { n.Edges.SlugAliases = append(n.Edges.SlugAliases, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (bpq *BlogPostQuery) loadAuthor(ctx context.Context, query *UserQuery, nodes []*BlogPost, init func(*BlogPost), assign func(*BlogPost, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BlogPost)
+	for i := range nodes {
+		if nodes[i].user_posts == nil {
+			continue
+		}
+		fk := *nodes[i].user_posts
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_posts" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bpq *BlogPostQuery) loadByline(ctx context.Context, query *AuthorQuery, nodes []*BlogPost, init func(*BlogPost), assign func(*BlogPost, *Author)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BlogPost)
+	for i := range nodes {
+		if nodes[i].author_posts == nil {
+			continue
+		}
+		fk := *nodes[i].author_posts
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(author.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "author_posts" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bpq *BlogPostQuery) loadCategory(ctx context.Context, query *CategoryQuery, nodes []*BlogPost, init func(*BlogPost), assign func(*BlogPost, *Category)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BlogPost)
+	for i := range nodes {
+		if nodes[i].category_posts == nil {
+			continue
+		}
+		fk := *nodes[i].category_posts
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(category.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "category_posts" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bpq *BlogPostQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*BlogPost, init func(*BlogPost), assign func(*BlogPost, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*BlogPost)
+	nids := make(map[int]map[*BlogPost]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(blogpost.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(blogpost.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(blogpost.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(blogpost.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*BlogPost]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (bpq *BlogPostQuery) loadMedia(ctx context.Context, query *MediaQuery, nodes []*BlogPost, init func(*BlogPost), assign func(*BlogPost, *Media)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BlogPost)
+	for i := range nodes {
+		if nodes[i].media_posts == nil {
+			continue
+		}
+		fk := *nodes[i].media_posts
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(media.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "media_posts" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bpq *BlogPostQuery) loadRevisions(ctx context.Context, query *PostRevisionQuery, nodes []*BlogPost, init func(*BlogPost), assign func(*BlogPost, *PostRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BlogPost)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PostRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(blogpost.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.blog_post_revisions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "blog_post_revisions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blog_post_revisions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (bpq *BlogPostQuery) loadSlugAliases(ctx context.Context, query *SlugAliasQuery, nodes []*BlogPost, init func(*BlogPost), assign func(*BlogPost, *SlugAlias)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BlogPost)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SlugAlias(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(blogpost.SlugAliasesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.blog_post_slug_aliases
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "blog_post_slug_aliases" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blog_post_slug_aliases" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (bpq *BlogPostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bpq.querySpec()
+	if len(bpq.modifiers) > 0 {
+		_spec.Modifiers = bpq.modifiers
+	}
 	_spec.Node.Columns = bpq.ctx.Fields
 	if len(bpq.ctx.Fields) > 0 {
 		_spec.Unique = bpq.ctx.Unique != nil && *bpq.ctx.Unique
@@ -419,6 +995,9 @@ func (bpq *BlogPostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if bpq.ctx.Unique != nil && *bpq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range bpq.modifiers {
+		m(selector)
+	}
 	for _, p := range bpq.predicates {
 		p(selector)
 	}
@@ -436,6 +1015,12 @@ func (bpq *BlogPostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bpq *BlogPostQuery) Modify(modifiers ...func(s *sql.Selector)) *BlogPostSelect {
+	bpq.modifiers = append(bpq.modifiers, modifiers...)
+	return bpq.Select()
+}
+
 // BlogPostGroupBy is the group-by builder for BlogPost entities.
 type BlogPostGroupBy struct {
 	selector
@@ -525,3 +1110,9 @@ func (bps *BlogPostSelect) sqlScan(ctx context.Context, root *BlogPostQuery, v a
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bps *BlogPostSelect) Modify(modifiers ...func(s *sql.Selector)) *BlogPostSelect {
+	bps.modifiers = append(bps.modifiers, modifiers...)
+	return bps
+}
@@ -10,16 +10,26 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
 	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/category"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/postrevision"
 	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+	"github.com/AdongoJr2/technoprise-backend/ent/tag"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
 )
 
 // BlogPostUpdate is the builder for updating BlogPost entities.
 type BlogPostUpdate struct {
 	config
-	hooks    []Hook
-	mutation *BlogPostMutation
+	hooks     []Hook
+	mutation  *BlogPostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the BlogPostUpdate builder.
@@ -34,6 +44,26 @@ func (bpu *BlogPostUpdate) SetUpdateTime(t time.Time) *BlogPostUpdate {
 	return bpu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (bpu *BlogPostUpdate) SetDeletedAt(t time.Time) *BlogPostUpdate {
+	bpu.mutation.SetDeletedAt(t)
+	return bpu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableDeletedAt(t *time.Time) *BlogPostUpdate {
+	if t != nil {
+		bpu.SetDeletedAt(*t)
+	}
+	return bpu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (bpu *BlogPostUpdate) ClearDeletedAt() *BlogPostUpdate {
+	bpu.mutation.ClearDeletedAt()
+	return bpu
+}
+
 // SetTitle sets the "title" field.
 func (bpu *BlogPostUpdate) SetTitle(s string) *BlogPostUpdate {
 	bpu.mutation.SetTitle(s)
@@ -76,6 +106,100 @@ func (bpu *BlogPostUpdate) SetNillableContent(s *string) *BlogPostUpdate {
 	return bpu
 }
 
+// SetContentFormat sets the "content_format" field.
+func (bpu *BlogPostUpdate) SetContentFormat(bf blogpost.ContentFormat) *BlogPostUpdate {
+	bpu.mutation.SetContentFormat(bf)
+	return bpu
+}
+
+// SetNillableContentFormat sets the "content_format" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableContentFormat(bf *blogpost.ContentFormat) *BlogPostUpdate {
+	if bf != nil {
+		bpu.SetContentFormat(*bf)
+	}
+	return bpu
+}
+
+// SetContentHTML sets the "content_html" field.
+func (bpu *BlogPostUpdate) SetContentHTML(s string) *BlogPostUpdate {
+	bpu.mutation.SetContentHTML(s)
+	return bpu
+}
+
+// SetNillableContentHTML sets the "content_html" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableContentHTML(s *string) *BlogPostUpdate {
+	if s != nil {
+		bpu.SetContentHTML(*s)
+	}
+	return bpu
+}
+
+// ClearContentHTML clears the value of the "content_html" field.
+func (bpu *BlogPostUpdate) ClearContentHTML() *BlogPostUpdate {
+	bpu.mutation.ClearContentHTML()
+	return bpu
+}
+
+// SetToc sets the "toc" field.
+func (bpu *BlogPostUpdate) SetToc(se []schematype.TOCEntry) *BlogPostUpdate {
+	bpu.mutation.SetToc(se)
+	return bpu
+}
+
+// AppendToc appends se to the "toc" field.
+func (bpu *BlogPostUpdate) AppendToc(se []schematype.TOCEntry) *BlogPostUpdate {
+	bpu.mutation.AppendToc(se)
+	return bpu
+}
+
+// ClearToc clears the value of the "toc" field.
+func (bpu *BlogPostUpdate) ClearToc() *BlogPostUpdate {
+	bpu.mutation.ClearToc()
+	return bpu
+}
+
+// SetWordCount sets the "word_count" field.
+func (bpu *BlogPostUpdate) SetWordCount(i int) *BlogPostUpdate {
+	bpu.mutation.ResetWordCount()
+	bpu.mutation.SetWordCount(i)
+	return bpu
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableWordCount(i *int) *BlogPostUpdate {
+	if i != nil {
+		bpu.SetWordCount(*i)
+	}
+	return bpu
+}
+
+// AddWordCount adds i to the "word_count" field.
+func (bpu *BlogPostUpdate) AddWordCount(i int) *BlogPostUpdate {
+	bpu.mutation.AddWordCount(i)
+	return bpu
+}
+
+// SetReadingTime sets the "reading_time" field.
+func (bpu *BlogPostUpdate) SetReadingTime(i int) *BlogPostUpdate {
+	bpu.mutation.ResetReadingTime()
+	bpu.mutation.SetReadingTime(i)
+	return bpu
+}
+
+// SetNillableReadingTime sets the "reading_time" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableReadingTime(i *int) *BlogPostUpdate {
+	if i != nil {
+		bpu.SetReadingTime(*i)
+	}
+	return bpu
+}
+
+// AddReadingTime adds i to the "reading_time" field.
+func (bpu *BlogPostUpdate) AddReadingTime(i int) *BlogPostUpdate {
+	bpu.mutation.AddReadingTime(i)
+	return bpu
+}
+
 // SetExcerpt sets the "excerpt" field.
 func (bpu *BlogPostUpdate) SetExcerpt(s string) *BlogPostUpdate {
 	bpu.mutation.SetExcerpt(s)
@@ -90,6 +214,20 @@ func (bpu *BlogPostUpdate) SetNillableExcerpt(s *string) *BlogPostUpdate {
 	return bpu
 }
 
+// SetExcerptGenerated sets the "excerpt_generated" field.
+func (bpu *BlogPostUpdate) SetExcerptGenerated(b bool) *BlogPostUpdate {
+	bpu.mutation.SetExcerptGenerated(b)
+	return bpu
+}
+
+// SetNillableExcerptGenerated sets the "excerpt_generated" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableExcerptGenerated(b *bool) *BlogPostUpdate {
+	if b != nil {
+		bpu.SetExcerptGenerated(*b)
+	}
+	return bpu
+}
+
 // SetImage sets the "image" field.
 func (bpu *BlogPostUpdate) SetImage(s string) *BlogPostUpdate {
 	bpu.mutation.SetImage(s)
@@ -110,6 +248,24 @@ func (bpu *BlogPostUpdate) ClearImage() *BlogPostUpdate {
 	return bpu
 }
 
+// SetImageVariants sets the "image_variants" field.
+func (bpu *BlogPostUpdate) SetImageVariants(sv []schematype.ImageVariant) *BlogPostUpdate {
+	bpu.mutation.SetImageVariants(sv)
+	return bpu
+}
+
+// AppendImageVariants appends sv to the "image_variants" field.
+func (bpu *BlogPostUpdate) AppendImageVariants(sv []schematype.ImageVariant) *BlogPostUpdate {
+	bpu.mutation.AppendImageVariants(sv)
+	return bpu
+}
+
+// ClearImageVariants clears the value of the "image_variants" field.
+func (bpu *BlogPostUpdate) ClearImageVariants() *BlogPostUpdate {
+	bpu.mutation.ClearImageVariants()
+	return bpu
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (bpu *BlogPostUpdate) SetPublishedAt(t time.Time) *BlogPostUpdate {
 	bpu.mutation.SetPublishedAt(t)
@@ -130,11 +286,233 @@ func (bpu *BlogPostUpdate) ClearPublishedAt() *BlogPostUpdate {
 	return bpu
 }
 
+// SetStatus sets the "status" field.
+func (bpu *BlogPostUpdate) SetStatus(b blogpost.Status) *BlogPostUpdate {
+	bpu.mutation.SetStatus(b)
+	return bpu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableStatus(b *blogpost.Status) *BlogPostUpdate {
+	if b != nil {
+		bpu.SetStatus(*b)
+	}
+	return bpu
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (bpu *BlogPostUpdate) SetAuthorID(id int) *BlogPostUpdate {
+	bpu.mutation.SetAuthorID(id)
+	return bpu
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableAuthorID(id *int) *BlogPostUpdate {
+	if id != nil {
+		bpu = bpu.SetAuthorID(*id)
+	}
+	return bpu
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (bpu *BlogPostUpdate) SetAuthor(u *User) *BlogPostUpdate {
+	return bpu.SetAuthorID(u.ID)
+}
+
+// SetBylineID sets the "byline" edge to the Author entity by ID.
+func (bpu *BlogPostUpdate) SetBylineID(id int) *BlogPostUpdate {
+	bpu.mutation.SetBylineID(id)
+	return bpu
+}
+
+// SetNillableBylineID sets the "byline" edge to the Author entity by ID if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableBylineID(id *int) *BlogPostUpdate {
+	if id != nil {
+		bpu = bpu.SetBylineID(*id)
+	}
+	return bpu
+}
+
+// SetByline sets the "byline" edge to the Author entity.
+func (bpu *BlogPostUpdate) SetByline(a *Author) *BlogPostUpdate {
+	return bpu.SetBylineID(a.ID)
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (bpu *BlogPostUpdate) SetCategoryID(id int) *BlogPostUpdate {
+	bpu.mutation.SetCategoryID(id)
+	return bpu
+}
+
+// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableCategoryID(id *int) *BlogPostUpdate {
+	if id != nil {
+		bpu = bpu.SetCategoryID(*id)
+	}
+	return bpu
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (bpu *BlogPostUpdate) SetCategory(c *Category) *BlogPostUpdate {
+	return bpu.SetCategoryID(c.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (bpu *BlogPostUpdate) AddTagIDs(ids ...int) *BlogPostUpdate {
+	bpu.mutation.AddTagIDs(ids...)
+	return bpu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (bpu *BlogPostUpdate) AddTags(t ...*Tag) *BlogPostUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bpu.AddTagIDs(ids...)
+}
+
+// SetMediaID sets the "media" edge to the Media entity by ID.
+func (bpu *BlogPostUpdate) SetMediaID(id int) *BlogPostUpdate {
+	bpu.mutation.SetMediaID(id)
+	return bpu
+}
+
+// SetNillableMediaID sets the "media" edge to the Media entity by ID if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableMediaID(id *int) *BlogPostUpdate {
+	if id != nil {
+		bpu = bpu.SetMediaID(*id)
+	}
+	return bpu
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (bpu *BlogPostUpdate) SetMedia(m *Media) *BlogPostUpdate {
+	return bpu.SetMediaID(m.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (bpu *BlogPostUpdate) AddRevisionIDs(ids ...int) *BlogPostUpdate {
+	bpu.mutation.AddRevisionIDs(ids...)
+	return bpu
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (bpu *BlogPostUpdate) AddRevisions(p ...*PostRevision) *BlogPostUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bpu.AddRevisionIDs(ids...)
+}
+
+// AddSlugAliasIDs adds the "slug_aliases" edge to the SlugAlias entity by IDs.
+func (bpu *BlogPostUpdate) AddSlugAliasIDs(ids ...int) *BlogPostUpdate {
+	bpu.mutation.AddSlugAliasIDs(ids...)
+	return bpu
+}
+
+// AddSlugAliases adds the "slug_aliases" edges to the SlugAlias entity.
+func (bpu *BlogPostUpdate) AddSlugAliases(s ...*SlugAlias) *BlogPostUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bpu.AddSlugAliasIDs(ids...)
+}
+
 // Mutation returns the BlogPostMutation object of the builder.
 func (bpu *BlogPostUpdate) Mutation() *BlogPostMutation {
 	return bpu.mutation
 }
 
+// ClearAuthor clears the "author" edge to the User entity.
+func (bpu *BlogPostUpdate) ClearAuthor() *BlogPostUpdate {
+	bpu.mutation.ClearAuthor()
+	return bpu
+}
+
+// ClearByline clears the "byline" edge to the Author entity.
+func (bpu *BlogPostUpdate) ClearByline() *BlogPostUpdate {
+	bpu.mutation.ClearByline()
+	return bpu
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (bpu *BlogPostUpdate) ClearCategory() *BlogPostUpdate {
+	bpu.mutation.ClearCategory()
+	return bpu
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (bpu *BlogPostUpdate) ClearTags() *BlogPostUpdate {
+	bpu.mutation.ClearTags()
+	return bpu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (bpu *BlogPostUpdate) RemoveTagIDs(ids ...int) *BlogPostUpdate {
+	bpu.mutation.RemoveTagIDs(ids...)
+	return bpu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (bpu *BlogPostUpdate) RemoveTags(t ...*Tag) *BlogPostUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bpu.RemoveTagIDs(ids...)
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (bpu *BlogPostUpdate) ClearMedia() *BlogPostUpdate {
+	bpu.mutation.ClearMedia()
+	return bpu
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (bpu *BlogPostUpdate) ClearRevisions() *BlogPostUpdate {
+	bpu.mutation.ClearRevisions()
+	return bpu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (bpu *BlogPostUpdate) RemoveRevisionIDs(ids ...int) *BlogPostUpdate {
+	bpu.mutation.RemoveRevisionIDs(ids...)
+	return bpu
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (bpu *BlogPostUpdate) RemoveRevisions(p ...*PostRevision) *BlogPostUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bpu.RemoveRevisionIDs(ids...)
+}
+
+// ClearSlugAliases clears all "slug_aliases" edges to the SlugAlias entity.
+func (bpu *BlogPostUpdate) ClearSlugAliases() *BlogPostUpdate {
+	bpu.mutation.ClearSlugAliases()
+	return bpu
+}
+
+// RemoveSlugAliasIDs removes the "slug_aliases" edge to SlugAlias entities by IDs.
+func (bpu *BlogPostUpdate) RemoveSlugAliasIDs(ids ...int) *BlogPostUpdate {
+	bpu.mutation.RemoveSlugAliasIDs(ids...)
+	return bpu
+}
+
+// RemoveSlugAliases removes "slug_aliases" edges to SlugAlias entities.
+func (bpu *BlogPostUpdate) RemoveSlugAliases(s ...*SlugAlias) *BlogPostUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bpu.RemoveSlugAliasIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bpu *BlogPostUpdate) Save(ctx context.Context) (int, error) {
 	bpu.defaults()
@@ -188,14 +566,40 @@ func (bpu *BlogPostUpdate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "BlogPost.content": %w`, err)}
 		}
 	}
+	if v, ok := bpu.mutation.ContentFormat(); ok {
+		if err := blogpost.ContentFormatValidator(v); err != nil {
+			return &ValidationError{Name: "content_format", err: fmt.Errorf(`ent: validator failed for field "BlogPost.content_format": %w`, err)}
+		}
+	}
+	if v, ok := bpu.mutation.WordCount(); ok {
+		if err := blogpost.WordCountValidator(v); err != nil {
+			return &ValidationError{Name: "word_count", err: fmt.Errorf(`ent: validator failed for field "BlogPost.word_count": %w`, err)}
+		}
+	}
+	if v, ok := bpu.mutation.ReadingTime(); ok {
+		if err := blogpost.ReadingTimeValidator(v); err != nil {
+			return &ValidationError{Name: "reading_time", err: fmt.Errorf(`ent: validator failed for field "BlogPost.reading_time": %w`, err)}
+		}
+	}
 	if v, ok := bpu.mutation.Excerpt(); ok {
 		if err := blogpost.ExcerptValidator(v); err != nil {
 			return &ValidationError{Name: "excerpt", err: fmt.Errorf(`ent: validator failed for field "BlogPost.excerpt": %w`, err)}
 		}
 	}
+	if v, ok := bpu.mutation.Status(); ok {
+		if err := blogpost.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BlogPost.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bpu *BlogPostUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlogPostUpdate {
+	bpu.modifiers = append(bpu.modifiers, modifiers...)
+	return bpu
+}
+
 func (bpu *BlogPostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := bpu.check(); err != nil {
 		return n, err
@@ -211,6 +615,12 @@ func (bpu *BlogPostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bpu.mutation.UpdateTime(); ok {
 		_spec.SetField(blogpost.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := bpu.mutation.DeletedAt(); ok {
+		_spec.SetField(blogpost.FieldDeletedAt, field.TypeTime, value)
+	}
+	if bpu.mutation.DeletedAtCleared() {
+		_spec.ClearField(blogpost.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := bpu.mutation.Title(); ok {
 		_spec.SetField(blogpost.FieldTitle, field.TypeString, value)
 	}
@@ -220,21 +630,322 @@ func (bpu *BlogPostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bpu.mutation.Content(); ok {
 		_spec.SetField(blogpost.FieldContent, field.TypeString, value)
 	}
+	if value, ok := bpu.mutation.ContentFormat(); ok {
+		_spec.SetField(blogpost.FieldContentFormat, field.TypeEnum, value)
+	}
+	if value, ok := bpu.mutation.ContentHTML(); ok {
+		_spec.SetField(blogpost.FieldContentHTML, field.TypeString, value)
+	}
+	if bpu.mutation.ContentHTMLCleared() {
+		_spec.ClearField(blogpost.FieldContentHTML, field.TypeString)
+	}
+	if value, ok := bpu.mutation.Toc(); ok {
+		_spec.SetField(blogpost.FieldToc, field.TypeJSON, value)
+	}
+	if value, ok := bpu.mutation.AppendedToc(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blogpost.FieldToc, value)
+		})
+	}
+	if bpu.mutation.TocCleared() {
+		_spec.ClearField(blogpost.FieldToc, field.TypeJSON)
+	}
+	if value, ok := bpu.mutation.WordCount(); ok {
+		_spec.SetField(blogpost.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.AddedWordCount(); ok {
+		_spec.AddField(blogpost.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.ReadingTime(); ok {
+		_spec.SetField(blogpost.FieldReadingTime, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.AddedReadingTime(); ok {
+		_spec.AddField(blogpost.FieldReadingTime, field.TypeInt, value)
+	}
 	if value, ok := bpu.mutation.Excerpt(); ok {
 		_spec.SetField(blogpost.FieldExcerpt, field.TypeString, value)
 	}
+	if value, ok := bpu.mutation.ExcerptGenerated(); ok {
+		_spec.SetField(blogpost.FieldExcerptGenerated, field.TypeBool, value)
+	}
 	if value, ok := bpu.mutation.Image(); ok {
 		_spec.SetField(blogpost.FieldImage, field.TypeString, value)
 	}
 	if bpu.mutation.ImageCleared() {
 		_spec.ClearField(blogpost.FieldImage, field.TypeString)
 	}
+	if value, ok := bpu.mutation.ImageVariants(); ok {
+		_spec.SetField(blogpost.FieldImageVariants, field.TypeJSON, value)
+	}
+	if value, ok := bpu.mutation.AppendedImageVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blogpost.FieldImageVariants, value)
+		})
+	}
+	if bpu.mutation.ImageVariantsCleared() {
+		_spec.ClearField(blogpost.FieldImageVariants, field.TypeJSON)
+	}
 	if value, ok := bpu.mutation.PublishedAt(); ok {
 		_spec.SetField(blogpost.FieldPublishedAt, field.TypeTime, value)
 	}
 	if bpu.mutation.PublishedAtCleared() {
 		_spec.ClearField(blogpost.FieldPublishedAt, field.TypeTime)
 	}
+	if value, ok := bpu.mutation.Status(); ok {
+		_spec.SetField(blogpost.FieldStatus, field.TypeEnum, value)
+	}
+	if bpu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpu.mutation.BylineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.BylineTable,
+			Columns: []string{blogpost.BylineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(author.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.BylineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.BylineTable,
+			Columns: []string{blogpost.BylineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(author.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpu.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.CategoryTable,
+			Columns: []string{blogpost.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.CategoryTable,
+			Columns: []string{blogpost.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogpost.TagsTable,
+			Columns: blogpost.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !bpu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogpost.TagsTable,
+			Columns: blogpost.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogpost.TagsTable,
+			Columns: blogpost.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.MediaTable,
+			Columns: []string{blogpost.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.MediaTable,
+			Columns: []string{blogpost.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.RevisionsTable,
+			Columns: []string{blogpost.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !bpu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.RevisionsTable,
+			Columns: []string{blogpost.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.RevisionsTable,
+			Columns: []string{blogpost.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpu.mutation.SlugAliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.SlugAliasesTable,
+			Columns: []string{blogpost.SlugAliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.RemovedSlugAliasesIDs(); len(nodes) > 0 && !bpu.mutation.SlugAliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.SlugAliasesTable,
+			Columns: []string{blogpost.SlugAliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.SlugAliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.SlugAliasesTable,
+			Columns: []string{blogpost.SlugAliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(bpu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, bpu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{blogpost.Label}
@@ -250,9 +961,10 @@ func (bpu *BlogPostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // BlogPostUpdateOne is the builder for updating a single BlogPost entity.
 type BlogPostUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *BlogPostMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *BlogPostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -261,6 +973,26 @@ func (bpuo *BlogPostUpdateOne) SetUpdateTime(t time.Time) *BlogPostUpdateOne {
 	return bpuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (bpuo *BlogPostUpdateOne) SetDeletedAt(t time.Time) *BlogPostUpdateOne {
+	bpuo.mutation.SetDeletedAt(t)
+	return bpuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableDeletedAt(t *time.Time) *BlogPostUpdateOne {
+	if t != nil {
+		bpuo.SetDeletedAt(*t)
+	}
+	return bpuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (bpuo *BlogPostUpdateOne) ClearDeletedAt() *BlogPostUpdateOne {
+	bpuo.mutation.ClearDeletedAt()
+	return bpuo
+}
+
 // SetTitle sets the "title" field.
 func (bpuo *BlogPostUpdateOne) SetTitle(s string) *BlogPostUpdateOne {
 	bpuo.mutation.SetTitle(s)
@@ -303,6 +1035,100 @@ func (bpuo *BlogPostUpdateOne) SetNillableContent(s *string) *BlogPostUpdateOne
 	return bpuo
 }
 
+// SetContentFormat sets the "content_format" field.
+func (bpuo *BlogPostUpdateOne) SetContentFormat(bf blogpost.ContentFormat) *BlogPostUpdateOne {
+	bpuo.mutation.SetContentFormat(bf)
+	return bpuo
+}
+
+// SetNillableContentFormat sets the "content_format" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableContentFormat(bf *blogpost.ContentFormat) *BlogPostUpdateOne {
+	if bf != nil {
+		bpuo.SetContentFormat(*bf)
+	}
+	return bpuo
+}
+
+// SetContentHTML sets the "content_html" field.
+func (bpuo *BlogPostUpdateOne) SetContentHTML(s string) *BlogPostUpdateOne {
+	bpuo.mutation.SetContentHTML(s)
+	return bpuo
+}
+
+// SetNillableContentHTML sets the "content_html" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableContentHTML(s *string) *BlogPostUpdateOne {
+	if s != nil {
+		bpuo.SetContentHTML(*s)
+	}
+	return bpuo
+}
+
+// ClearContentHTML clears the value of the "content_html" field.
+func (bpuo *BlogPostUpdateOne) ClearContentHTML() *BlogPostUpdateOne {
+	bpuo.mutation.ClearContentHTML()
+	return bpuo
+}
+
+// SetToc sets the "toc" field.
+func (bpuo *BlogPostUpdateOne) SetToc(se []schematype.TOCEntry) *BlogPostUpdateOne {
+	bpuo.mutation.SetToc(se)
+	return bpuo
+}
+
+// AppendToc appends se to the "toc" field.
+func (bpuo *BlogPostUpdateOne) AppendToc(se []schematype.TOCEntry) *BlogPostUpdateOne {
+	bpuo.mutation.AppendToc(se)
+	return bpuo
+}
+
+// ClearToc clears the value of the "toc" field.
+func (bpuo *BlogPostUpdateOne) ClearToc() *BlogPostUpdateOne {
+	bpuo.mutation.ClearToc()
+	return bpuo
+}
+
+// SetWordCount sets the "word_count" field.
+func (bpuo *BlogPostUpdateOne) SetWordCount(i int) *BlogPostUpdateOne {
+	bpuo.mutation.ResetWordCount()
+	bpuo.mutation.SetWordCount(i)
+	return bpuo
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableWordCount(i *int) *BlogPostUpdateOne {
+	if i != nil {
+		bpuo.SetWordCount(*i)
+	}
+	return bpuo
+}
+
+// AddWordCount adds i to the "word_count" field.
+func (bpuo *BlogPostUpdateOne) AddWordCount(i int) *BlogPostUpdateOne {
+	bpuo.mutation.AddWordCount(i)
+	return bpuo
+}
+
+// SetReadingTime sets the "reading_time" field.
+func (bpuo *BlogPostUpdateOne) SetReadingTime(i int) *BlogPostUpdateOne {
+	bpuo.mutation.ResetReadingTime()
+	bpuo.mutation.SetReadingTime(i)
+	return bpuo
+}
+
+// SetNillableReadingTime sets the "reading_time" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableReadingTime(i *int) *BlogPostUpdateOne {
+	if i != nil {
+		bpuo.SetReadingTime(*i)
+	}
+	return bpuo
+}
+
+// AddReadingTime adds i to the "reading_time" field.
+func (bpuo *BlogPostUpdateOne) AddReadingTime(i int) *BlogPostUpdateOne {
+	bpuo.mutation.AddReadingTime(i)
+	return bpuo
+}
+
 // SetExcerpt sets the "excerpt" field.
 func (bpuo *BlogPostUpdateOne) SetExcerpt(s string) *BlogPostUpdateOne {
 	bpuo.mutation.SetExcerpt(s)
@@ -317,6 +1143,20 @@ func (bpuo *BlogPostUpdateOne) SetNillableExcerpt(s *string) *BlogPostUpdateOne
 	return bpuo
 }
 
+// SetExcerptGenerated sets the "excerpt_generated" field.
+func (bpuo *BlogPostUpdateOne) SetExcerptGenerated(b bool) *BlogPostUpdateOne {
+	bpuo.mutation.SetExcerptGenerated(b)
+	return bpuo
+}
+
+// SetNillableExcerptGenerated sets the "excerpt_generated" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableExcerptGenerated(b *bool) *BlogPostUpdateOne {
+	if b != nil {
+		bpuo.SetExcerptGenerated(*b)
+	}
+	return bpuo
+}
+
 // SetImage sets the "image" field.
 func (bpuo *BlogPostUpdateOne) SetImage(s string) *BlogPostUpdateOne {
 	bpuo.mutation.SetImage(s)
@@ -337,6 +1177,24 @@ func (bpuo *BlogPostUpdateOne) ClearImage() *BlogPostUpdateOne {
 	return bpuo
 }
 
+// SetImageVariants sets the "image_variants" field.
+func (bpuo *BlogPostUpdateOne) SetImageVariants(sv []schematype.ImageVariant) *BlogPostUpdateOne {
+	bpuo.mutation.SetImageVariants(sv)
+	return bpuo
+}
+
+// AppendImageVariants appends sv to the "image_variants" field.
+func (bpuo *BlogPostUpdateOne) AppendImageVariants(sv []schematype.ImageVariant) *BlogPostUpdateOne {
+	bpuo.mutation.AppendImageVariants(sv)
+	return bpuo
+}
+
+// ClearImageVariants clears the value of the "image_variants" field.
+func (bpuo *BlogPostUpdateOne) ClearImageVariants() *BlogPostUpdateOne {
+	bpuo.mutation.ClearImageVariants()
+	return bpuo
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (bpuo *BlogPostUpdateOne) SetPublishedAt(t time.Time) *BlogPostUpdateOne {
 	bpuo.mutation.SetPublishedAt(t)
@@ -357,11 +1215,233 @@ func (bpuo *BlogPostUpdateOne) ClearPublishedAt() *BlogPostUpdateOne {
 	return bpuo
 }
 
+// SetStatus sets the "status" field.
+func (bpuo *BlogPostUpdateOne) SetStatus(b blogpost.Status) *BlogPostUpdateOne {
+	bpuo.mutation.SetStatus(b)
+	return bpuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableStatus(b *blogpost.Status) *BlogPostUpdateOne {
+	if b != nil {
+		bpuo.SetStatus(*b)
+	}
+	return bpuo
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (bpuo *BlogPostUpdateOne) SetAuthorID(id int) *BlogPostUpdateOne {
+	bpuo.mutation.SetAuthorID(id)
+	return bpuo
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableAuthorID(id *int) *BlogPostUpdateOne {
+	if id != nil {
+		bpuo = bpuo.SetAuthorID(*id)
+	}
+	return bpuo
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (bpuo *BlogPostUpdateOne) SetAuthor(u *User) *BlogPostUpdateOne {
+	return bpuo.SetAuthorID(u.ID)
+}
+
+// SetBylineID sets the "byline" edge to the Author entity by ID.
+func (bpuo *BlogPostUpdateOne) SetBylineID(id int) *BlogPostUpdateOne {
+	bpuo.mutation.SetBylineID(id)
+	return bpuo
+}
+
+// SetNillableBylineID sets the "byline" edge to the Author entity by ID if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableBylineID(id *int) *BlogPostUpdateOne {
+	if id != nil {
+		bpuo = bpuo.SetBylineID(*id)
+	}
+	return bpuo
+}
+
+// SetByline sets the "byline" edge to the Author entity.
+func (bpuo *BlogPostUpdateOne) SetByline(a *Author) *BlogPostUpdateOne {
+	return bpuo.SetBylineID(a.ID)
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (bpuo *BlogPostUpdateOne) SetCategoryID(id int) *BlogPostUpdateOne {
+	bpuo.mutation.SetCategoryID(id)
+	return bpuo
+}
+
+// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableCategoryID(id *int) *BlogPostUpdateOne {
+	if id != nil {
+		bpuo = bpuo.SetCategoryID(*id)
+	}
+	return bpuo
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (bpuo *BlogPostUpdateOne) SetCategory(c *Category) *BlogPostUpdateOne {
+	return bpuo.SetCategoryID(c.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (bpuo *BlogPostUpdateOne) AddTagIDs(ids ...int) *BlogPostUpdateOne {
+	bpuo.mutation.AddTagIDs(ids...)
+	return bpuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (bpuo *BlogPostUpdateOne) AddTags(t ...*Tag) *BlogPostUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bpuo.AddTagIDs(ids...)
+}
+
+// SetMediaID sets the "media" edge to the Media entity by ID.
+func (bpuo *BlogPostUpdateOne) SetMediaID(id int) *BlogPostUpdateOne {
+	bpuo.mutation.SetMediaID(id)
+	return bpuo
+}
+
+// SetNillableMediaID sets the "media" edge to the Media entity by ID if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableMediaID(id *int) *BlogPostUpdateOne {
+	if id != nil {
+		bpuo = bpuo.SetMediaID(*id)
+	}
+	return bpuo
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (bpuo *BlogPostUpdateOne) SetMedia(m *Media) *BlogPostUpdateOne {
+	return bpuo.SetMediaID(m.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (bpuo *BlogPostUpdateOne) AddRevisionIDs(ids ...int) *BlogPostUpdateOne {
+	bpuo.mutation.AddRevisionIDs(ids...)
+	return bpuo
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (bpuo *BlogPostUpdateOne) AddRevisions(p ...*PostRevision) *BlogPostUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bpuo.AddRevisionIDs(ids...)
+}
+
+// AddSlugAliasIDs adds the "slug_aliases" edge to the SlugAlias entity by IDs.
+func (bpuo *BlogPostUpdateOne) AddSlugAliasIDs(ids ...int) *BlogPostUpdateOne {
+	bpuo.mutation.AddSlugAliasIDs(ids...)
+	return bpuo
+}
+
+// AddSlugAliases adds the "slug_aliases" edges to the SlugAlias entity.
+func (bpuo *BlogPostUpdateOne) AddSlugAliases(s ...*SlugAlias) *BlogPostUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bpuo.AddSlugAliasIDs(ids...)
+}
+
 // Mutation returns the BlogPostMutation object of the builder.
 func (bpuo *BlogPostUpdateOne) Mutation() *BlogPostMutation {
 	return bpuo.mutation
 }
 
+// ClearAuthor clears the "author" edge to the User entity.
+func (bpuo *BlogPostUpdateOne) ClearAuthor() *BlogPostUpdateOne {
+	bpuo.mutation.ClearAuthor()
+	return bpuo
+}
+
+// ClearByline clears the "byline" edge to the Author entity.
+func (bpuo *BlogPostUpdateOne) ClearByline() *BlogPostUpdateOne {
+	bpuo.mutation.ClearByline()
+	return bpuo
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (bpuo *BlogPostUpdateOne) ClearCategory() *BlogPostUpdateOne {
+	bpuo.mutation.ClearCategory()
+	return bpuo
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (bpuo *BlogPostUpdateOne) ClearTags() *BlogPostUpdateOne {
+	bpuo.mutation.ClearTags()
+	return bpuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (bpuo *BlogPostUpdateOne) RemoveTagIDs(ids ...int) *BlogPostUpdateOne {
+	bpuo.mutation.RemoveTagIDs(ids...)
+	return bpuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (bpuo *BlogPostUpdateOne) RemoveTags(t ...*Tag) *BlogPostUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bpuo.RemoveTagIDs(ids...)
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (bpuo *BlogPostUpdateOne) ClearMedia() *BlogPostUpdateOne {
+	bpuo.mutation.ClearMedia()
+	return bpuo
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (bpuo *BlogPostUpdateOne) ClearRevisions() *BlogPostUpdateOne {
+	bpuo.mutation.ClearRevisions()
+	return bpuo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (bpuo *BlogPostUpdateOne) RemoveRevisionIDs(ids ...int) *BlogPostUpdateOne {
+	bpuo.mutation.RemoveRevisionIDs(ids...)
+	return bpuo
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (bpuo *BlogPostUpdateOne) RemoveRevisions(p ...*PostRevision) *BlogPostUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bpuo.RemoveRevisionIDs(ids...)
+}
+
+// ClearSlugAliases clears all "slug_aliases" edges to the SlugAlias entity.
+func (bpuo *BlogPostUpdateOne) ClearSlugAliases() *BlogPostUpdateOne {
+	bpuo.mutation.ClearSlugAliases()
+	return bpuo
+}
+
+// RemoveSlugAliasIDs removes the "slug_aliases" edge to SlugAlias entities by IDs.
+func (bpuo *BlogPostUpdateOne) RemoveSlugAliasIDs(ids ...int) *BlogPostUpdateOne {
+	bpuo.mutation.RemoveSlugAliasIDs(ids...)
+	return bpuo
+}
+
+// RemoveSlugAliases removes "slug_aliases" edges to SlugAlias entities.
+func (bpuo *BlogPostUpdateOne) RemoveSlugAliases(s ...*SlugAlias) *BlogPostUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bpuo.RemoveSlugAliasIDs(ids...)
+}
+
 // Where appends a list predicates to the BlogPostUpdate builder.
 func (bpuo *BlogPostUpdateOne) Where(ps ...predicate.BlogPost) *BlogPostUpdateOne {
 	bpuo.mutation.Where(ps...)
@@ -428,14 +1508,40 @@ func (bpuo *BlogPostUpdateOne) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "BlogPost.content": %w`, err)}
 		}
 	}
+	if v, ok := bpuo.mutation.ContentFormat(); ok {
+		if err := blogpost.ContentFormatValidator(v); err != nil {
+			return &ValidationError{Name: "content_format", err: fmt.Errorf(`ent: validator failed for field "BlogPost.content_format": %w`, err)}
+		}
+	}
+	if v, ok := bpuo.mutation.WordCount(); ok {
+		if err := blogpost.WordCountValidator(v); err != nil {
+			return &ValidationError{Name: "word_count", err: fmt.Errorf(`ent: validator failed for field "BlogPost.word_count": %w`, err)}
+		}
+	}
+	if v, ok := bpuo.mutation.ReadingTime(); ok {
+		if err := blogpost.ReadingTimeValidator(v); err != nil {
+			return &ValidationError{Name: "reading_time", err: fmt.Errorf(`ent: validator failed for field "BlogPost.reading_time": %w`, err)}
+		}
+	}
 	if v, ok := bpuo.mutation.Excerpt(); ok {
 		if err := blogpost.ExcerptValidator(v); err != nil {
 			return &ValidationError{Name: "excerpt", err: fmt.Errorf(`ent: validator failed for field "BlogPost.excerpt": %w`, err)}
 		}
 	}
+	if v, ok := bpuo.mutation.Status(); ok {
+		if err := blogpost.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BlogPost.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bpuo *BlogPostUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlogPostUpdateOne {
+	bpuo.modifiers = append(bpuo.modifiers, modifiers...)
+	return bpuo
+}
+
 func (bpuo *BlogPostUpdateOne) sqlSave(ctx context.Context) (_node *BlogPost, err error) {
 	if err := bpuo.check(); err != nil {
 		return _node, err
@@ -468,6 +1574,12 @@ func (bpuo *BlogPostUpdateOne) sqlSave(ctx context.Context) (_node *BlogPost, er
 	if value, ok := bpuo.mutation.UpdateTime(); ok {
 		_spec.SetField(blogpost.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := bpuo.mutation.DeletedAt(); ok {
+		_spec.SetField(blogpost.FieldDeletedAt, field.TypeTime, value)
+	}
+	if bpuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(blogpost.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := bpuo.mutation.Title(); ok {
 		_spec.SetField(blogpost.FieldTitle, field.TypeString, value)
 	}
@@ -477,21 +1589,322 @@ func (bpuo *BlogPostUpdateOne) sqlSave(ctx context.Context) (_node *BlogPost, er
 	if value, ok := bpuo.mutation.Content(); ok {
 		_spec.SetField(blogpost.FieldContent, field.TypeString, value)
 	}
+	if value, ok := bpuo.mutation.ContentFormat(); ok {
+		_spec.SetField(blogpost.FieldContentFormat, field.TypeEnum, value)
+	}
+	if value, ok := bpuo.mutation.ContentHTML(); ok {
+		_spec.SetField(blogpost.FieldContentHTML, field.TypeString, value)
+	}
+	if bpuo.mutation.ContentHTMLCleared() {
+		_spec.ClearField(blogpost.FieldContentHTML, field.TypeString)
+	}
+	if value, ok := bpuo.mutation.Toc(); ok {
+		_spec.SetField(blogpost.FieldToc, field.TypeJSON, value)
+	}
+	if value, ok := bpuo.mutation.AppendedToc(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blogpost.FieldToc, value)
+		})
+	}
+	if bpuo.mutation.TocCleared() {
+		_spec.ClearField(blogpost.FieldToc, field.TypeJSON)
+	}
+	if value, ok := bpuo.mutation.WordCount(); ok {
+		_spec.SetField(blogpost.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.AddedWordCount(); ok {
+		_spec.AddField(blogpost.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.ReadingTime(); ok {
+		_spec.SetField(blogpost.FieldReadingTime, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.AddedReadingTime(); ok {
+		_spec.AddField(blogpost.FieldReadingTime, field.TypeInt, value)
+	}
 	if value, ok := bpuo.mutation.Excerpt(); ok {
 		_spec.SetField(blogpost.FieldExcerpt, field.TypeString, value)
 	}
+	if value, ok := bpuo.mutation.ExcerptGenerated(); ok {
+		_spec.SetField(blogpost.FieldExcerptGenerated, field.TypeBool, value)
+	}
 	if value, ok := bpuo.mutation.Image(); ok {
 		_spec.SetField(blogpost.FieldImage, field.TypeString, value)
 	}
 	if bpuo.mutation.ImageCleared() {
 		_spec.ClearField(blogpost.FieldImage, field.TypeString)
 	}
+	if value, ok := bpuo.mutation.ImageVariants(); ok {
+		_spec.SetField(blogpost.FieldImageVariants, field.TypeJSON, value)
+	}
+	if value, ok := bpuo.mutation.AppendedImageVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blogpost.FieldImageVariants, value)
+		})
+	}
+	if bpuo.mutation.ImageVariantsCleared() {
+		_spec.ClearField(blogpost.FieldImageVariants, field.TypeJSON)
+	}
 	if value, ok := bpuo.mutation.PublishedAt(); ok {
 		_spec.SetField(blogpost.FieldPublishedAt, field.TypeTime, value)
 	}
 	if bpuo.mutation.PublishedAtCleared() {
 		_spec.ClearField(blogpost.FieldPublishedAt, field.TypeTime)
 	}
+	if value, ok := bpuo.mutation.Status(); ok {
+		_spec.SetField(blogpost.FieldStatus, field.TypeEnum, value)
+	}
+	if bpuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpuo.mutation.BylineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.BylineTable,
+			Columns: []string{blogpost.BylineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(author.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.BylineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.BylineTable,
+			Columns: []string{blogpost.BylineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(author.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpuo.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.CategoryTable,
+			Columns: []string{blogpost.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.CategoryTable,
+			Columns: []string{blogpost.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogpost.TagsTable,
+			Columns: blogpost.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !bpuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogpost.TagsTable,
+			Columns: blogpost.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogpost.TagsTable,
+			Columns: blogpost.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpuo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.MediaTable,
+			Columns: []string{blogpost.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.MediaTable,
+			Columns: []string{blogpost.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.RevisionsTable,
+			Columns: []string{blogpost.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !bpuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.RevisionsTable,
+			Columns: []string{blogpost.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.RevisionsTable,
+			Columns: []string{blogpost.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpuo.mutation.SlugAliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.SlugAliasesTable,
+			Columns: []string{blogpost.SlugAliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.RemovedSlugAliasesIDs(); len(nodes) > 0 && !bpuo.mutation.SlugAliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.SlugAliasesTable,
+			Columns: []string{blogpost.SlugAliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.SlugAliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blogpost.SlugAliasesTable,
+			Columns: []string{blogpost.SlugAliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(bpuo.modifiers...)
 	_node = &BlogPost{config: bpuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
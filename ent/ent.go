@@ -12,7 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
 	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/category"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/postrevision"
+	"github.com/AdongoJr2/technoprise-backend/ent/session"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+	"github.com/AdongoJr2/technoprise-backend/ent/tag"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +81,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			blogpost.Table: blogpost.ValidColumn,
+			author.Table:       author.ValidColumn,
+			blogpost.Table:     blogpost.ValidColumn,
+			category.Table:     category.ValidColumn,
+			media.Table:        media.ValidColumn,
+			postrevision.Table: postrevision.ValidColumn,
+			session.Table:      session.ValidColumn,
+			slugalias.Table:    slugalias.ValidColumn,
+			tag.Table:          tag.ValidColumn,
+			user.Table:         user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
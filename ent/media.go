@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+)
+
+// Media is the model entity for the Media schema.
+type Media struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// Format holds the value of the "format" field.
+	Format string `json:"format,omitempty"`
+	// MimeType holds the value of the "mime_type" field.
+	MimeType string `json:"mime_type,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// AltText holds the value of the "alt_text" field.
+	AltText string `json:"alt_text,omitempty"`
+	// Caption holds the value of the "caption" field.
+	Caption string `json:"caption,omitempty"`
+	// Variants holds the value of the "variants" field.
+	Variants []schematype.ImageVariant `json:"variants,omitempty"`
+	// PostUpload holds the value of the "post_upload" field.
+	PostUpload bool `json:"post_upload,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MediaQuery when eager-loading is set.
+	Edges        MediaEdges `json:"edges"`
+	user_media   *int
+	selectValues sql.SelectValues
+}
+
+// MediaEdges holds the relations/edges for other nodes in the graph.
+type MediaEdges struct {
+	// Uploader holds the value of the uploader edge.
+	Uploader *User `json:"uploader,omitempty"`
+	// Posts holds the value of the posts edge.
+	Posts []*BlogPost `json:"posts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UploaderOrErr returns the Uploader value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MediaEdges) UploaderOrErr() (*User, error) {
+	if e.Uploader != nil {
+		return e.Uploader, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "uploader"}
+}
+
+// PostsOrErr returns the Posts value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) PostsOrErr() ([]*BlogPost, error) {
+	if e.loadedTypes[1] {
+		return e.Posts, nil
+	}
+	return nil, &NotLoadedError{edge: "posts"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Media) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case media.FieldVariants:
+			values[i] = new([]byte)
+		case media.FieldPostUpload:
+			values[i] = new(sql.NullBool)
+		case media.FieldID, media.FieldWidth, media.FieldHeight, media.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case media.FieldKey, media.FieldFilename, media.FieldFormat, media.FieldMimeType, media.FieldAltText, media.FieldCaption:
+			values[i] = new(sql.NullString)
+		case media.FieldCreateTime, media.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case media.ForeignKeys[0]: // user_media
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Media fields.
+func (m *Media) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case media.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case media.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				m.CreateTime = value.Time
+			}
+		case media.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				m.UpdateTime = value.Time
+			}
+		case media.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				m.Key = value.String
+			}
+		case media.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				m.Filename = value.String
+			}
+		case media.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				m.Format = value.String
+			}
+		case media.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				m.MimeType = value.String
+			}
+		case media.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				m.Width = int(value.Int64)
+			}
+		case media.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				m.Height = int(value.Int64)
+			}
+		case media.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				m.Size = value.Int64
+			}
+		case media.FieldAltText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alt_text", values[i])
+			} else if value.Valid {
+				m.AltText = value.String
+			}
+		case media.FieldCaption:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caption", values[i])
+			} else if value.Valid {
+				m.Caption = value.String
+			}
+		case media.FieldVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Variants); err != nil {
+					return fmt.Errorf("unmarshal field variants: %w", err)
+				}
+			}
+		case media.FieldPostUpload:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field post_upload", values[i])
+			} else if value.Valid {
+				m.PostUpload = value.Bool
+			}
+		case media.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_media", value)
+			} else if value.Valid {
+				m.user_media = new(int)
+				*m.user_media = int(value.Int64)
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Media.
+// This includes values selected through modifiers, order, etc.
+func (m *Media) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// QueryUploader queries the "uploader" edge of the Media entity.
+func (m *Media) QueryUploader() *UserQuery {
+	return NewMediaClient(m.config).QueryUploader(m)
+}
+
+// QueryPosts queries the "posts" edge of the Media entity.
+func (m *Media) QueryPosts() *BlogPostQuery {
+	return NewMediaClient(m.config).QueryPosts(m)
+}
+
+// Update returns a builder for updating this Media.
+// Note that you need to call Media.Unwrap() before calling this method if this Media
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Media) Update() *MediaUpdateOne {
+	return NewMediaClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Media entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Media) Unwrap() *Media {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Media is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Media) String() string {
+	var builder strings.Builder
+	builder.WriteString("Media(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(m.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(m.Format)
+	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(m.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", m.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", m.Height))
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("alt_text=")
+	builder.WriteString(m.AltText)
+	builder.WriteString(", ")
+	builder.WriteString("caption=")
+	builder.WriteString(m.Caption)
+	builder.WriteString(", ")
+	builder.WriteString("variants=")
+	builder.WriteString(fmt.Sprintf("%v", m.Variants))
+	builder.WriteString(", ")
+	builder.WriteString("post_upload=")
+	builder.WriteString(fmt.Sprintf("%v", m.PostUpload))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MediaSlice is a parsable slice of Media.
+type MediaSlice []*Media
@@ -0,0 +1,817 @@
+// Code generated by ent, DO NOT EDIT.
+
+package media
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldKey, v))
+}
+
+// Filename applies equality check predicate on the "filename" field. It's identical to FilenameEQ.
+func Filename(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFilename, v))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFormat, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldMimeType, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldHeight, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldSize, v))
+}
+
+// AltText applies equality check predicate on the "alt_text" field. It's identical to AltTextEQ.
+func AltText(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldAltText, v))
+}
+
+// Caption applies equality check predicate on the "caption" field. It's identical to CaptionEQ.
+func Caption(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldCaption, v))
+}
+
+// PostUpload applies equality check predicate on the "post_upload" field. It's identical to PostUploadEQ.
+func PostUpload(v bool) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldPostUpload, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldKey, v))
+}
+
+// FilenameEQ applies the EQ predicate on the "filename" field.
+func FilenameEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFilename, v))
+}
+
+// FilenameNEQ applies the NEQ predicate on the "filename" field.
+func FilenameNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldFilename, v))
+}
+
+// FilenameIn applies the In predicate on the "filename" field.
+func FilenameIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldFilename, vs...))
+}
+
+// FilenameNotIn applies the NotIn predicate on the "filename" field.
+func FilenameNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldFilename, vs...))
+}
+
+// FilenameGT applies the GT predicate on the "filename" field.
+func FilenameGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldFilename, v))
+}
+
+// FilenameGTE applies the GTE predicate on the "filename" field.
+func FilenameGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldFilename, v))
+}
+
+// FilenameLT applies the LT predicate on the "filename" field.
+func FilenameLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldFilename, v))
+}
+
+// FilenameLTE applies the LTE predicate on the "filename" field.
+func FilenameLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldFilename, v))
+}
+
+// FilenameContains applies the Contains predicate on the "filename" field.
+func FilenameContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldFilename, v))
+}
+
+// FilenameHasPrefix applies the HasPrefix predicate on the "filename" field.
+func FilenameHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldFilename, v))
+}
+
+// FilenameHasSuffix applies the HasSuffix predicate on the "filename" field.
+func FilenameHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldFilename, v))
+}
+
+// FilenameIsNil applies the IsNil predicate on the "filename" field.
+func FilenameIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldFilename))
+}
+
+// FilenameNotNil applies the NotNil predicate on the "filename" field.
+func FilenameNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldFilename))
+}
+
+// FilenameEqualFold applies the EqualFold predicate on the "filename" field.
+func FilenameEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldFilename, v))
+}
+
+// FilenameContainsFold applies the ContainsFold predicate on the "filename" field.
+func FilenameContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldFilename, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldHeight, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldSize, v))
+}
+
+// AltTextEQ applies the EQ predicate on the "alt_text" field.
+func AltTextEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldAltText, v))
+}
+
+// AltTextNEQ applies the NEQ predicate on the "alt_text" field.
+func AltTextNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldAltText, v))
+}
+
+// AltTextIn applies the In predicate on the "alt_text" field.
+func AltTextIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldAltText, vs...))
+}
+
+// AltTextNotIn applies the NotIn predicate on the "alt_text" field.
+func AltTextNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldAltText, vs...))
+}
+
+// AltTextGT applies the GT predicate on the "alt_text" field.
+func AltTextGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldAltText, v))
+}
+
+// AltTextGTE applies the GTE predicate on the "alt_text" field.
+func AltTextGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldAltText, v))
+}
+
+// AltTextLT applies the LT predicate on the "alt_text" field.
+func AltTextLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldAltText, v))
+}
+
+// AltTextLTE applies the LTE predicate on the "alt_text" field.
+func AltTextLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldAltText, v))
+}
+
+// AltTextContains applies the Contains predicate on the "alt_text" field.
+func AltTextContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldAltText, v))
+}
+
+// AltTextHasPrefix applies the HasPrefix predicate on the "alt_text" field.
+func AltTextHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldAltText, v))
+}
+
+// AltTextHasSuffix applies the HasSuffix predicate on the "alt_text" field.
+func AltTextHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldAltText, v))
+}
+
+// AltTextIsNil applies the IsNil predicate on the "alt_text" field.
+func AltTextIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldAltText))
+}
+
+// AltTextNotNil applies the NotNil predicate on the "alt_text" field.
+func AltTextNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldAltText))
+}
+
+// AltTextEqualFold applies the EqualFold predicate on the "alt_text" field.
+func AltTextEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldAltText, v))
+}
+
+// AltTextContainsFold applies the ContainsFold predicate on the "alt_text" field.
+func AltTextContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldAltText, v))
+}
+
+// CaptionEQ applies the EQ predicate on the "caption" field.
+func CaptionEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldCaption, v))
+}
+
+// CaptionNEQ applies the NEQ predicate on the "caption" field.
+func CaptionNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldCaption, v))
+}
+
+// CaptionIn applies the In predicate on the "caption" field.
+func CaptionIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldCaption, vs...))
+}
+
+// CaptionNotIn applies the NotIn predicate on the "caption" field.
+func CaptionNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldCaption, vs...))
+}
+
+// CaptionGT applies the GT predicate on the "caption" field.
+func CaptionGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldCaption, v))
+}
+
+// CaptionGTE applies the GTE predicate on the "caption" field.
+func CaptionGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldCaption, v))
+}
+
+// CaptionLT applies the LT predicate on the "caption" field.
+func CaptionLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldCaption, v))
+}
+
+// CaptionLTE applies the LTE predicate on the "caption" field.
+func CaptionLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldCaption, v))
+}
+
+// CaptionContains applies the Contains predicate on the "caption" field.
+func CaptionContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldCaption, v))
+}
+
+// CaptionHasPrefix applies the HasPrefix predicate on the "caption" field.
+func CaptionHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldCaption, v))
+}
+
+// CaptionHasSuffix applies the HasSuffix predicate on the "caption" field.
+func CaptionHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldCaption, v))
+}
+
+// CaptionIsNil applies the IsNil predicate on the "caption" field.
+func CaptionIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldCaption))
+}
+
+// CaptionNotNil applies the NotNil predicate on the "caption" field.
+func CaptionNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldCaption))
+}
+
+// CaptionEqualFold applies the EqualFold predicate on the "caption" field.
+func CaptionEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldCaption, v))
+}
+
+// CaptionContainsFold applies the ContainsFold predicate on the "caption" field.
+func CaptionContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldCaption, v))
+}
+
+// VariantsIsNil applies the IsNil predicate on the "variants" field.
+func VariantsIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldVariants))
+}
+
+// VariantsNotNil applies the NotNil predicate on the "variants" field.
+func VariantsNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldVariants))
+}
+
+// PostUploadEQ applies the EQ predicate on the "post_upload" field.
+func PostUploadEQ(v bool) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldPostUpload, v))
+}
+
+// PostUploadNEQ applies the NEQ predicate on the "post_upload" field.
+func PostUploadNEQ(v bool) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldPostUpload, v))
+}
+
+// HasUploader applies the HasEdge predicate on the "uploader" edge.
+func HasUploader() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UploaderTable, UploaderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUploaderWith applies the HasEdge predicate on the "uploader" edge with a given conditions (other predicates).
+func HasUploaderWith(preds ...predicate.User) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newUploaderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPosts applies the HasEdge predicate on the "posts" edge.
+func HasPosts() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PostsTable, PostsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostsWith applies the HasEdge predicate on the "posts" edge with a given conditions (other predicates).
+func HasPostsWith(preds ...predicate.BlogPost) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newPostsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Media) predicate.Media {
+	return predicate.Media(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Media) predicate.Media {
+	return predicate.Media(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Media) predicate.Media {
+	return predicate.Media(sql.NotPredicates(p))
+}
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+)
+
+// MediaCreate is the builder for creating a Media entity.
+type MediaCreate struct {
+	config
+	mutation *MediaMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (mc *MediaCreate) SetCreateTime(t time.Time) *MediaCreate {
+	mc.mutation.SetCreateTime(t)
+	return mc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableCreateTime(t *time.Time) *MediaCreate {
+	if t != nil {
+		mc.SetCreateTime(*t)
+	}
+	return mc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (mc *MediaCreate) SetUpdateTime(t time.Time) *MediaCreate {
+	mc.mutation.SetUpdateTime(t)
+	return mc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableUpdateTime(t *time.Time) *MediaCreate {
+	if t != nil {
+		mc.SetUpdateTime(*t)
+	}
+	return mc
+}
+
+// SetKey sets the "key" field.
+func (mc *MediaCreate) SetKey(s string) *MediaCreate {
+	mc.mutation.SetKey(s)
+	return mc
+}
+
+// SetFilename sets the "filename" field.
+func (mc *MediaCreate) SetFilename(s string) *MediaCreate {
+	mc.mutation.SetFilename(s)
+	return mc
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableFilename(s *string) *MediaCreate {
+	if s != nil {
+		mc.SetFilename(*s)
+	}
+	return mc
+}
+
+// SetFormat sets the "format" field.
+func (mc *MediaCreate) SetFormat(s string) *MediaCreate {
+	mc.mutation.SetFormat(s)
+	return mc
+}
+
+// SetMimeType sets the "mime_type" field.
+func (mc *MediaCreate) SetMimeType(s string) *MediaCreate {
+	mc.mutation.SetMimeType(s)
+	return mc
+}
+
+// SetWidth sets the "width" field.
+func (mc *MediaCreate) SetWidth(i int) *MediaCreate {
+	mc.mutation.SetWidth(i)
+	return mc
+}
+
+// SetHeight sets the "height" field.
+func (mc *MediaCreate) SetHeight(i int) *MediaCreate {
+	mc.mutation.SetHeight(i)
+	return mc
+}
+
+// SetSize sets the "size" field.
+func (mc *MediaCreate) SetSize(i int64) *MediaCreate {
+	mc.mutation.SetSize(i)
+	return mc
+}
+
+// SetAltText sets the "alt_text" field.
+func (mc *MediaCreate) SetAltText(s string) *MediaCreate {
+	mc.mutation.SetAltText(s)
+	return mc
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableAltText(s *string) *MediaCreate {
+	if s != nil {
+		mc.SetAltText(*s)
+	}
+	return mc
+}
+
+// SetCaption sets the "caption" field.
+func (mc *MediaCreate) SetCaption(s string) *MediaCreate {
+	mc.mutation.SetCaption(s)
+	return mc
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableCaption(s *string) *MediaCreate {
+	if s != nil {
+		mc.SetCaption(*s)
+	}
+	return mc
+}
+
+// SetVariants sets the "variants" field.
+func (mc *MediaCreate) SetVariants(sv []schematype.ImageVariant) *MediaCreate {
+	mc.mutation.SetVariants(sv)
+	return mc
+}
+
+// SetPostUpload sets the "post_upload" field.
+func (mc *MediaCreate) SetPostUpload(b bool) *MediaCreate {
+	mc.mutation.SetPostUpload(b)
+	return mc
+}
+
+// SetNillablePostUpload sets the "post_upload" field if the given value is not nil.
+func (mc *MediaCreate) SetNillablePostUpload(b *bool) *MediaCreate {
+	if b != nil {
+		mc.SetPostUpload(*b)
+	}
+	return mc
+}
+
+// SetUploaderID sets the "uploader" edge to the User entity by ID.
+func (mc *MediaCreate) SetUploaderID(id int) *MediaCreate {
+	mc.mutation.SetUploaderID(id)
+	return mc
+}
+
+// SetNillableUploaderID sets the "uploader" edge to the User entity by ID if the given value is not nil.
+func (mc *MediaCreate) SetNillableUploaderID(id *int) *MediaCreate {
+	if id != nil {
+		mc = mc.SetUploaderID(*id)
+	}
+	return mc
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (mc *MediaCreate) SetUploader(u *User) *MediaCreate {
+	return mc.SetUploaderID(u.ID)
+}
+
+// AddPostIDs adds the "posts" edge to the BlogPost entity by IDs.
+func (mc *MediaCreate) AddPostIDs(ids ...int) *MediaCreate {
+	mc.mutation.AddPostIDs(ids...)
+	return mc
+}
+
+// AddPosts adds the "posts" edges to the BlogPost entity.
+func (mc *MediaCreate) AddPosts(b ...*BlogPost) *MediaCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return mc.AddPostIDs(ids...)
+}
+
+// Mutation returns the MediaMutation object of the builder.
+func (mc *MediaCreate) Mutation() *MediaMutation {
+	return mc.mutation
+}
+
+// Save creates the Media in the database.
+func (mc *MediaCreate) Save(ctx context.Context) (*Media, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MediaCreate) SaveX(ctx context.Context) *Media {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MediaCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MediaCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MediaCreate) defaults() {
+	if _, ok := mc.mutation.CreateTime(); !ok {
+		v := media.DefaultCreateTime()
+		mc.mutation.SetCreateTime(v)
+	}
+	if _, ok := mc.mutation.UpdateTime(); !ok {
+		v := media.DefaultUpdateTime()
+		mc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := mc.mutation.PostUpload(); !ok {
+		v := media.DefaultPostUpload
+		mc.mutation.SetPostUpload(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MediaCreate) check() error {
+	if _, ok := mc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Media.create_time"`)}
+	}
+	if _, ok := mc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Media.update_time"`)}
+	}
+	if _, ok := mc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "Media.key"`)}
+	}
+	if v, ok := mc.mutation.Key(); ok {
+		if err := media.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Media.key": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Media.format"`)}
+	}
+	if v, ok := mc.mutation.Format(); ok {
+		if err := media.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Media.format": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.MimeType(); !ok {
+		return &ValidationError{Name: "mime_type", err: errors.New(`ent: missing required field "Media.mime_type"`)}
+	}
+	if v, ok := mc.mutation.MimeType(); ok {
+		if err := media.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "Media.mime_type": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Media.width"`)}
+	}
+	if v, ok := mc.mutation.Width(); ok {
+		if err := media.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Media.width": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Media.height"`)}
+	}
+	if v, ok := mc.mutation.Height(); ok {
+		if err := media.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Media.height": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Media.size"`)}
+	}
+	if v, ok := mc.mutation.Size(); ok {
+		if err := media.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Media.size": %w`, err)}
+		}
+	}
+	if v, ok := mc.mutation.AltText(); ok {
+		if err := media.AltTextValidator(v); err != nil {
+			return &ValidationError{Name: "alt_text", err: fmt.Errorf(`ent: validator failed for field "Media.alt_text": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.PostUpload(); !ok {
+		return &ValidationError{Name: "post_upload", err: errors.New(`ent: missing required field "Media.post_upload"`)}
+	}
+	return nil
+}
+
+func (mc *MediaCreate) sqlSave(ctx context.Context) (*Media, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Media{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(media.Table, sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt))
+	)
+	if value, ok := mc.mutation.CreateTime(); ok {
+		_spec.SetField(media.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := mc.mutation.UpdateTime(); ok {
+		_spec.SetField(media.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := mc.mutation.Key(); ok {
+		_spec.SetField(media.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := mc.mutation.Filename(); ok {
+		_spec.SetField(media.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := mc.mutation.Format(); ok {
+		_spec.SetField(media.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := mc.mutation.MimeType(); ok {
+		_spec.SetField(media.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := mc.mutation.Width(); ok {
+		_spec.SetField(media.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := mc.mutation.Height(); ok {
+		_spec.SetField(media.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := mc.mutation.Size(); ok {
+		_spec.SetField(media.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := mc.mutation.AltText(); ok {
+		_spec.SetField(media.FieldAltText, field.TypeString, value)
+		_node.AltText = value
+	}
+	if value, ok := mc.mutation.Caption(); ok {
+		_spec.SetField(media.FieldCaption, field.TypeString, value)
+		_node.Caption = value
+	}
+	if value, ok := mc.mutation.Variants(); ok {
+		_spec.SetField(media.FieldVariants, field.TypeJSON, value)
+		_node.Variants = value
+	}
+	if value, ok := mc.mutation.PostUpload(); ok {
+		_spec.SetField(media.FieldPostUpload, field.TypeBool, value)
+		_node.PostUpload = value
+	}
+	if nodes := mc.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.UploaderTable,
+			Columns: []string{media.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_media = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.PostsTable,
+			Columns: []string{media.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MediaCreateBulk is the builder for creating many Media entities in bulk.
+type MediaCreateBulk struct {
+	config
+	err      error
+	builders []*MediaCreate
+}
+
+// Save creates the Media entities in the database.
+func (mcb *MediaCreateBulk) Save(ctx context.Context) ([]*Media, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Media, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MediaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MediaCreateBulk) SaveX(ctx context.Context) []*Media {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MediaCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MediaCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
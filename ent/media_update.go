@@ -0,0 +1,1056 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+)
+
+// MediaUpdate is the builder for updating Media entities.
+type MediaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MediaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MediaUpdate builder.
+func (mu *MediaUpdate) Where(ps ...predicate.Media) *MediaUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (mu *MediaUpdate) SetUpdateTime(t time.Time) *MediaUpdate {
+	mu.mutation.SetUpdateTime(t)
+	return mu
+}
+
+// SetFilename sets the "filename" field.
+func (mu *MediaUpdate) SetFilename(s string) *MediaUpdate {
+	mu.mutation.SetFilename(s)
+	return mu
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableFilename(s *string) *MediaUpdate {
+	if s != nil {
+		mu.SetFilename(*s)
+	}
+	return mu
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (mu *MediaUpdate) ClearFilename() *MediaUpdate {
+	mu.mutation.ClearFilename()
+	return mu
+}
+
+// SetFormat sets the "format" field.
+func (mu *MediaUpdate) SetFormat(s string) *MediaUpdate {
+	mu.mutation.SetFormat(s)
+	return mu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableFormat(s *string) *MediaUpdate {
+	if s != nil {
+		mu.SetFormat(*s)
+	}
+	return mu
+}
+
+// SetMimeType sets the "mime_type" field.
+func (mu *MediaUpdate) SetMimeType(s string) *MediaUpdate {
+	mu.mutation.SetMimeType(s)
+	return mu
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableMimeType(s *string) *MediaUpdate {
+	if s != nil {
+		mu.SetMimeType(*s)
+	}
+	return mu
+}
+
+// SetWidth sets the "width" field.
+func (mu *MediaUpdate) SetWidth(i int) *MediaUpdate {
+	mu.mutation.ResetWidth()
+	mu.mutation.SetWidth(i)
+	return mu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableWidth(i *int) *MediaUpdate {
+	if i != nil {
+		mu.SetWidth(*i)
+	}
+	return mu
+}
+
+// AddWidth adds i to the "width" field.
+func (mu *MediaUpdate) AddWidth(i int) *MediaUpdate {
+	mu.mutation.AddWidth(i)
+	return mu
+}
+
+// SetHeight sets the "height" field.
+func (mu *MediaUpdate) SetHeight(i int) *MediaUpdate {
+	mu.mutation.ResetHeight()
+	mu.mutation.SetHeight(i)
+	return mu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableHeight(i *int) *MediaUpdate {
+	if i != nil {
+		mu.SetHeight(*i)
+	}
+	return mu
+}
+
+// AddHeight adds i to the "height" field.
+func (mu *MediaUpdate) AddHeight(i int) *MediaUpdate {
+	mu.mutation.AddHeight(i)
+	return mu
+}
+
+// SetSize sets the "size" field.
+func (mu *MediaUpdate) SetSize(i int64) *MediaUpdate {
+	mu.mutation.ResetSize()
+	mu.mutation.SetSize(i)
+	return mu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableSize(i *int64) *MediaUpdate {
+	if i != nil {
+		mu.SetSize(*i)
+	}
+	return mu
+}
+
+// AddSize adds i to the "size" field.
+func (mu *MediaUpdate) AddSize(i int64) *MediaUpdate {
+	mu.mutation.AddSize(i)
+	return mu
+}
+
+// SetAltText sets the "alt_text" field.
+func (mu *MediaUpdate) SetAltText(s string) *MediaUpdate {
+	mu.mutation.SetAltText(s)
+	return mu
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableAltText(s *string) *MediaUpdate {
+	if s != nil {
+		mu.SetAltText(*s)
+	}
+	return mu
+}
+
+// ClearAltText clears the value of the "alt_text" field.
+func (mu *MediaUpdate) ClearAltText() *MediaUpdate {
+	mu.mutation.ClearAltText()
+	return mu
+}
+
+// SetCaption sets the "caption" field.
+func (mu *MediaUpdate) SetCaption(s string) *MediaUpdate {
+	mu.mutation.SetCaption(s)
+	return mu
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableCaption(s *string) *MediaUpdate {
+	if s != nil {
+		mu.SetCaption(*s)
+	}
+	return mu
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (mu *MediaUpdate) ClearCaption() *MediaUpdate {
+	mu.mutation.ClearCaption()
+	return mu
+}
+
+// SetVariants sets the "variants" field.
+func (mu *MediaUpdate) SetVariants(sv []schematype.ImageVariant) *MediaUpdate {
+	mu.mutation.SetVariants(sv)
+	return mu
+}
+
+// AppendVariants appends sv to the "variants" field.
+func (mu *MediaUpdate) AppendVariants(sv []schematype.ImageVariant) *MediaUpdate {
+	mu.mutation.AppendVariants(sv)
+	return mu
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (mu *MediaUpdate) ClearVariants() *MediaUpdate {
+	mu.mutation.ClearVariants()
+	return mu
+}
+
+// SetPostUpload sets the "post_upload" field.
+func (mu *MediaUpdate) SetPostUpload(b bool) *MediaUpdate {
+	mu.mutation.SetPostUpload(b)
+	return mu
+}
+
+// SetNillablePostUpload sets the "post_upload" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillablePostUpload(b *bool) *MediaUpdate {
+	if b != nil {
+		mu.SetPostUpload(*b)
+	}
+	return mu
+}
+
+// SetUploaderID sets the "uploader" edge to the User entity by ID.
+func (mu *MediaUpdate) SetUploaderID(id int) *MediaUpdate {
+	mu.mutation.SetUploaderID(id)
+	return mu
+}
+
+// SetNillableUploaderID sets the "uploader" edge to the User entity by ID if the given value is not nil.
+func (mu *MediaUpdate) SetNillableUploaderID(id *int) *MediaUpdate {
+	if id != nil {
+		mu = mu.SetUploaderID(*id)
+	}
+	return mu
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (mu *MediaUpdate) SetUploader(u *User) *MediaUpdate {
+	return mu.SetUploaderID(u.ID)
+}
+
+// AddPostIDs adds the "posts" edge to the BlogPost entity by IDs.
+func (mu *MediaUpdate) AddPostIDs(ids ...int) *MediaUpdate {
+	mu.mutation.AddPostIDs(ids...)
+	return mu
+}
+
+// AddPosts adds the "posts" edges to the BlogPost entity.
+func (mu *MediaUpdate) AddPosts(b ...*BlogPost) *MediaUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return mu.AddPostIDs(ids...)
+}
+
+// Mutation returns the MediaMutation object of the builder.
+func (mu *MediaUpdate) Mutation() *MediaMutation {
+	return mu.mutation
+}
+
+// ClearUploader clears the "uploader" edge to the User entity.
+func (mu *MediaUpdate) ClearUploader() *MediaUpdate {
+	mu.mutation.ClearUploader()
+	return mu
+}
+
+// ClearPosts clears all "posts" edges to the BlogPost entity.
+func (mu *MediaUpdate) ClearPosts() *MediaUpdate {
+	mu.mutation.ClearPosts()
+	return mu
+}
+
+// RemovePostIDs removes the "posts" edge to BlogPost entities by IDs.
+func (mu *MediaUpdate) RemovePostIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemovePostIDs(ids...)
+	return mu
+}
+
+// RemovePosts removes "posts" edges to BlogPost entities.
+func (mu *MediaUpdate) RemovePosts(b ...*BlogPost) *MediaUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return mu.RemovePostIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MediaUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MediaUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MediaUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MediaUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MediaUpdate) defaults() {
+	if _, ok := mu.mutation.UpdateTime(); !ok {
+		v := media.UpdateDefaultUpdateTime()
+		mu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MediaUpdate) check() error {
+	if v, ok := mu.mutation.Format(); ok {
+		if err := media.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Media.format": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.MimeType(); ok {
+		if err := media.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "Media.mime_type": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Width(); ok {
+		if err := media.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Media.width": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Height(); ok {
+		if err := media.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Media.height": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Size(); ok {
+		if err := media.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Media.size": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.AltText(); ok {
+		if err := media.AltTextValidator(v); err != nil {
+			return &ValidationError{Name: "alt_text", err: fmt.Errorf(`ent: validator failed for field "Media.alt_text": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mu *MediaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MediaUpdate {
+	mu.modifiers = append(mu.modifiers, modifiers...)
+	return mu
+}
+
+func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(media.Table, media.Columns, sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.UpdateTime(); ok {
+		_spec.SetField(media.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := mu.mutation.Filename(); ok {
+		_spec.SetField(media.FieldFilename, field.TypeString, value)
+	}
+	if mu.mutation.FilenameCleared() {
+		_spec.ClearField(media.FieldFilename, field.TypeString)
+	}
+	if value, ok := mu.mutation.Format(); ok {
+		_spec.SetField(media.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.MimeType(); ok {
+		_spec.SetField(media.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Width(); ok {
+		_spec.SetField(media.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedWidth(); ok {
+		_spec.AddField(media.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.Height(); ok {
+		_spec.SetField(media.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedHeight(); ok {
+		_spec.AddField(media.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.Size(); ok {
+		_spec.SetField(media.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.AddedSize(); ok {
+		_spec.AddField(media.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.AltText(); ok {
+		_spec.SetField(media.FieldAltText, field.TypeString, value)
+	}
+	if mu.mutation.AltTextCleared() {
+		_spec.ClearField(media.FieldAltText, field.TypeString)
+	}
+	if value, ok := mu.mutation.Caption(); ok {
+		_spec.SetField(media.FieldCaption, field.TypeString, value)
+	}
+	if mu.mutation.CaptionCleared() {
+		_spec.ClearField(media.FieldCaption, field.TypeString)
+	}
+	if value, ok := mu.mutation.Variants(); ok {
+		_spec.SetField(media.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, media.FieldVariants, value)
+		})
+	}
+	if mu.mutation.VariantsCleared() {
+		_spec.ClearField(media.FieldVariants, field.TypeJSON)
+	}
+	if value, ok := mu.mutation.PostUpload(); ok {
+		_spec.SetField(media.FieldPostUpload, field.TypeBool, value)
+	}
+	if mu.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.UploaderTable,
+			Columns: []string{media.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.UploaderTable,
+			Columns: []string{media.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.PostsTable,
+			Columns: []string{media.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedPostsIDs(); len(nodes) > 0 && !mu.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.PostsTable,
+			Columns: []string{media.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.PostsTable,
+			Columns: []string{media.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{media.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MediaUpdateOne is the builder for updating a single Media entity.
+type MediaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MediaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (muo *MediaUpdateOne) SetUpdateTime(t time.Time) *MediaUpdateOne {
+	muo.mutation.SetUpdateTime(t)
+	return muo
+}
+
+// SetFilename sets the "filename" field.
+func (muo *MediaUpdateOne) SetFilename(s string) *MediaUpdateOne {
+	muo.mutation.SetFilename(s)
+	return muo
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableFilename(s *string) *MediaUpdateOne {
+	if s != nil {
+		muo.SetFilename(*s)
+	}
+	return muo
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (muo *MediaUpdateOne) ClearFilename() *MediaUpdateOne {
+	muo.mutation.ClearFilename()
+	return muo
+}
+
+// SetFormat sets the "format" field.
+func (muo *MediaUpdateOne) SetFormat(s string) *MediaUpdateOne {
+	muo.mutation.SetFormat(s)
+	return muo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableFormat(s *string) *MediaUpdateOne {
+	if s != nil {
+		muo.SetFormat(*s)
+	}
+	return muo
+}
+
+// SetMimeType sets the "mime_type" field.
+func (muo *MediaUpdateOne) SetMimeType(s string) *MediaUpdateOne {
+	muo.mutation.SetMimeType(s)
+	return muo
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableMimeType(s *string) *MediaUpdateOne {
+	if s != nil {
+		muo.SetMimeType(*s)
+	}
+	return muo
+}
+
+// SetWidth sets the "width" field.
+func (muo *MediaUpdateOne) SetWidth(i int) *MediaUpdateOne {
+	muo.mutation.ResetWidth()
+	muo.mutation.SetWidth(i)
+	return muo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableWidth(i *int) *MediaUpdateOne {
+	if i != nil {
+		muo.SetWidth(*i)
+	}
+	return muo
+}
+
+// AddWidth adds i to the "width" field.
+func (muo *MediaUpdateOne) AddWidth(i int) *MediaUpdateOne {
+	muo.mutation.AddWidth(i)
+	return muo
+}
+
+// SetHeight sets the "height" field.
+func (muo *MediaUpdateOne) SetHeight(i int) *MediaUpdateOne {
+	muo.mutation.ResetHeight()
+	muo.mutation.SetHeight(i)
+	return muo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableHeight(i *int) *MediaUpdateOne {
+	if i != nil {
+		muo.SetHeight(*i)
+	}
+	return muo
+}
+
+// AddHeight adds i to the "height" field.
+func (muo *MediaUpdateOne) AddHeight(i int) *MediaUpdateOne {
+	muo.mutation.AddHeight(i)
+	return muo
+}
+
+// SetSize sets the "size" field.
+func (muo *MediaUpdateOne) SetSize(i int64) *MediaUpdateOne {
+	muo.mutation.ResetSize()
+	muo.mutation.SetSize(i)
+	return muo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableSize(i *int64) *MediaUpdateOne {
+	if i != nil {
+		muo.SetSize(*i)
+	}
+	return muo
+}
+
+// AddSize adds i to the "size" field.
+func (muo *MediaUpdateOne) AddSize(i int64) *MediaUpdateOne {
+	muo.mutation.AddSize(i)
+	return muo
+}
+
+// SetAltText sets the "alt_text" field.
+func (muo *MediaUpdateOne) SetAltText(s string) *MediaUpdateOne {
+	muo.mutation.SetAltText(s)
+	return muo
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableAltText(s *string) *MediaUpdateOne {
+	if s != nil {
+		muo.SetAltText(*s)
+	}
+	return muo
+}
+
+// ClearAltText clears the value of the "alt_text" field.
+func (muo *MediaUpdateOne) ClearAltText() *MediaUpdateOne {
+	muo.mutation.ClearAltText()
+	return muo
+}
+
+// SetCaption sets the "caption" field.
+func (muo *MediaUpdateOne) SetCaption(s string) *MediaUpdateOne {
+	muo.mutation.SetCaption(s)
+	return muo
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableCaption(s *string) *MediaUpdateOne {
+	if s != nil {
+		muo.SetCaption(*s)
+	}
+	return muo
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (muo *MediaUpdateOne) ClearCaption() *MediaUpdateOne {
+	muo.mutation.ClearCaption()
+	return muo
+}
+
+// SetVariants sets the "variants" field.
+func (muo *MediaUpdateOne) SetVariants(sv []schematype.ImageVariant) *MediaUpdateOne {
+	muo.mutation.SetVariants(sv)
+	return muo
+}
+
+// AppendVariants appends sv to the "variants" field.
+func (muo *MediaUpdateOne) AppendVariants(sv []schematype.ImageVariant) *MediaUpdateOne {
+	muo.mutation.AppendVariants(sv)
+	return muo
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (muo *MediaUpdateOne) ClearVariants() *MediaUpdateOne {
+	muo.mutation.ClearVariants()
+	return muo
+}
+
+// SetPostUpload sets the "post_upload" field.
+func (muo *MediaUpdateOne) SetPostUpload(b bool) *MediaUpdateOne {
+	muo.mutation.SetPostUpload(b)
+	return muo
+}
+
+// SetNillablePostUpload sets the "post_upload" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillablePostUpload(b *bool) *MediaUpdateOne {
+	if b != nil {
+		muo.SetPostUpload(*b)
+	}
+	return muo
+}
+
+// SetUploaderID sets the "uploader" edge to the User entity by ID.
+func (muo *MediaUpdateOne) SetUploaderID(id int) *MediaUpdateOne {
+	muo.mutation.SetUploaderID(id)
+	return muo
+}
+
+// SetNillableUploaderID sets the "uploader" edge to the User entity by ID if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableUploaderID(id *int) *MediaUpdateOne {
+	if id != nil {
+		muo = muo.SetUploaderID(*id)
+	}
+	return muo
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (muo *MediaUpdateOne) SetUploader(u *User) *MediaUpdateOne {
+	return muo.SetUploaderID(u.ID)
+}
+
+// AddPostIDs adds the "posts" edge to the BlogPost entity by IDs.
+func (muo *MediaUpdateOne) AddPostIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.AddPostIDs(ids...)
+	return muo
+}
+
+// AddPosts adds the "posts" edges to the BlogPost entity.
+func (muo *MediaUpdateOne) AddPosts(b ...*BlogPost) *MediaUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return muo.AddPostIDs(ids...)
+}
+
+// Mutation returns the MediaMutation object of the builder.
+func (muo *MediaUpdateOne) Mutation() *MediaMutation {
+	return muo.mutation
+}
+
+// ClearUploader clears the "uploader" edge to the User entity.
+func (muo *MediaUpdateOne) ClearUploader() *MediaUpdateOne {
+	muo.mutation.ClearUploader()
+	return muo
+}
+
+// ClearPosts clears all "posts" edges to the BlogPost entity.
+func (muo *MediaUpdateOne) ClearPosts() *MediaUpdateOne {
+	muo.mutation.ClearPosts()
+	return muo
+}
+
+// RemovePostIDs removes the "posts" edge to BlogPost entities by IDs.
+func (muo *MediaUpdateOne) RemovePostIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.RemovePostIDs(ids...)
+	return muo
+}
+
+// RemovePosts removes "posts" edges to BlogPost entities.
+func (muo *MediaUpdateOne) RemovePosts(b ...*BlogPost) *MediaUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return muo.RemovePostIDs(ids...)
+}
+
+// Where appends a list predicates to the MediaUpdate builder.
+func (muo *MediaUpdateOne) Where(ps ...predicate.Media) *MediaUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MediaUpdateOne) Select(field string, fields ...string) *MediaUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Media entity.
+func (muo *MediaUpdateOne) Save(ctx context.Context) (*Media, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MediaUpdateOne) SaveX(ctx context.Context) *Media {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MediaUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MediaUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MediaUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdateTime(); !ok {
+		v := media.UpdateDefaultUpdateTime()
+		muo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MediaUpdateOne) check() error {
+	if v, ok := muo.mutation.Format(); ok {
+		if err := media.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Media.format": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.MimeType(); ok {
+		if err := media.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "Media.mime_type": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Width(); ok {
+		if err := media.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Media.width": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Height(); ok {
+		if err := media.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Media.height": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Size(); ok {
+		if err := media.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Media.size": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.AltText(); ok {
+		if err := media.AltTextValidator(v); err != nil {
+			return &ValidationError{Name: "alt_text", err: fmt.Errorf(`ent: validator failed for field "Media.alt_text": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (muo *MediaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MediaUpdateOne {
+	muo.modifiers = append(muo.modifiers, modifiers...)
+	return muo
+}
+
+func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(media.Table, media.Columns, sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Media.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, media.FieldID)
+		for _, f := range fields {
+			if !media.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != media.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.UpdateTime(); ok {
+		_spec.SetField(media.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := muo.mutation.Filename(); ok {
+		_spec.SetField(media.FieldFilename, field.TypeString, value)
+	}
+	if muo.mutation.FilenameCleared() {
+		_spec.ClearField(media.FieldFilename, field.TypeString)
+	}
+	if value, ok := muo.mutation.Format(); ok {
+		_spec.SetField(media.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.MimeType(); ok {
+		_spec.SetField(media.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Width(); ok {
+		_spec.SetField(media.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedWidth(); ok {
+		_spec.AddField(media.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.Height(); ok {
+		_spec.SetField(media.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedHeight(); ok {
+		_spec.AddField(media.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.Size(); ok {
+		_spec.SetField(media.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.AddedSize(); ok {
+		_spec.AddField(media.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.AltText(); ok {
+		_spec.SetField(media.FieldAltText, field.TypeString, value)
+	}
+	if muo.mutation.AltTextCleared() {
+		_spec.ClearField(media.FieldAltText, field.TypeString)
+	}
+	if value, ok := muo.mutation.Caption(); ok {
+		_spec.SetField(media.FieldCaption, field.TypeString, value)
+	}
+	if muo.mutation.CaptionCleared() {
+		_spec.ClearField(media.FieldCaption, field.TypeString)
+	}
+	if value, ok := muo.mutation.Variants(); ok {
+		_spec.SetField(media.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, media.FieldVariants, value)
+		})
+	}
+	if muo.mutation.VariantsCleared() {
+		_spec.ClearField(media.FieldVariants, field.TypeJSON)
+	}
+	if value, ok := muo.mutation.PostUpload(); ok {
+		_spec.SetField(media.FieldPostUpload, field.TypeBool, value)
+	}
+	if muo.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.UploaderTable,
+			Columns: []string{media.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.UploaderTable,
+			Columns: []string{media.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.PostsTable,
+			Columns: []string{media.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedPostsIDs(); len(nodes) > 0 && !muo.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.PostsTable,
+			Columns: []string{media.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.PostsTable,
+			Columns: []string{media.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(muo.modifiers...)
+	_node = &Media{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{media.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
@@ -8,36 +8,341 @@ import (
 )
 
 var (
+	// AuthorsColumns holds the columns for the "authors" table.
+	AuthorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "avatar", Type: field.TypeString, Nullable: true},
+		{Name: "social_links", Type: field.TypeJSON, Nullable: true},
+		{Name: "user_author_profile", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// AuthorsTable holds the schema information for the "authors" table.
+	AuthorsTable = &schema.Table{
+		Name:       "authors",
+		Columns:    AuthorsColumns,
+		PrimaryKey: []*schema.Column{AuthorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "authors_users_author_profile",
+				Columns:    []*schema.Column{AuthorsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// BlogPostsColumns holds the columns for the "blog_posts" table.
 	BlogPostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "slug", Type: field.TypeString, Unique: true},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "content_format", Type: field.TypeEnum, Enums: []string{"markdown", "html", "plaintext"}, Default: "markdown"},
+		{Name: "content_html", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "toc", Type: field.TypeJSON, Nullable: true},
+		{Name: "word_count", Type: field.TypeInt, Default: 0},
+		{Name: "reading_time", Type: field.TypeInt, Default: 0},
 		{Name: "excerpt", Type: field.TypeString, Size: 160},
+		{Name: "excerpt_generated", Type: field.TypeBool, Default: false},
 		{Name: "image", Type: field.TypeString, Nullable: true},
+		{Name: "image_variants", Type: field.TypeJSON, Nullable: true},
 		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "scheduled", "published", "archived"}, Default: "published"},
+		{Name: "author_posts", Type: field.TypeInt, Nullable: true},
+		{Name: "category_posts", Type: field.TypeInt, Nullable: true},
+		{Name: "media_posts", Type: field.TypeInt, Nullable: true},
+		{Name: "user_posts", Type: field.TypeInt, Nullable: true},
 	}
 	// BlogPostsTable holds the schema information for the "blog_posts" table.
 	BlogPostsTable = &schema.Table{
 		Name:       "blog_posts",
 		Columns:    BlogPostsColumns,
 		PrimaryKey: []*schema.Column{BlogPostsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blog_posts_authors_posts",
+				Columns:    []*schema.Column{BlogPostsColumns[18]},
+				RefColumns: []*schema.Column{AuthorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "blog_posts_categories_posts",
+				Columns:    []*schema.Column{BlogPostsColumns[19]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "blog_posts_media_posts",
+				Columns:    []*schema.Column{BlogPostsColumns[20]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "blog_posts_users_posts",
+				Columns:    []*schema.Column{BlogPostsColumns[21]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "blogpost_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{BlogPostsColumns[3]},
+			},
 			{
 				Name:    "blogpost_slug",
 				Unique:  false,
-				Columns: []*schema.Column{BlogPostsColumns[4]},
+				Columns: []*schema.Column{BlogPostsColumns[5]},
+			},
+			{
+				Name:    "blogpost_status_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{BlogPostsColumns[17], BlogPostsColumns[16]},
+			},
+			{
+				Name:    "blogpost_create_time_id",
+				Unique:  false,
+				Columns: []*schema.Column{BlogPostsColumns[1], BlogPostsColumns[0]},
+			},
+		},
+	}
+	// CategoriesColumns holds the columns for the "categories" table.
+	CategoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CategoriesTable holds the schema information for the "categories" table.
+	CategoriesTable = &schema.Table{
+		Name:       "categories",
+		Columns:    CategoriesColumns,
+		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_categories_children",
+				Columns:    []*schema.Column{CategoriesColumns[6]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// MediaColumns holds the columns for the "media" table.
+	MediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "filename", Type: field.TypeString, Nullable: true},
+		{Name: "format", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString},
+		{Name: "width", Type: field.TypeInt},
+		{Name: "height", Type: field.TypeInt},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "alt_text", Type: field.TypeString, Nullable: true, Size: 300},
+		{Name: "caption", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "variants", Type: field.TypeJSON, Nullable: true},
+		{Name: "post_upload", Type: field.TypeBool, Default: false},
+		{Name: "user_media", Type: field.TypeInt, Nullable: true},
+	}
+	// MediaTable holds the schema information for the "media" table.
+	MediaTable = &schema.Table{
+		Name:       "media",
+		Columns:    MediaColumns,
+		PrimaryKey: []*schema.Column{MediaColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "media_users_media",
+				Columns:    []*schema.Column{MediaColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "excerpt", Type: field.TypeString},
+		{Name: "excerpt_generated", Type: field.TypeBool, Default: false},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "content_format", Type: field.TypeEnum, Enums: []string{"markdown", "html", "plaintext"}, Default: "markdown"},
+		{Name: "restored_from", Type: field.TypeInt, Nullable: true},
+		{Name: "blog_post_revisions", Type: field.TypeInt},
+		{Name: "user_revisions", Type: field.TypeInt, Nullable: true},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revisions_blog_posts_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[9]},
+				RefColumns: []*schema.Column{BlogPostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "post_revisions_users_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postrevision_number_blog_post_revisions",
+				Unique:  true,
+				Columns: []*schema.Column{PostRevisionsColumns[2], PostRevisionsColumns[9]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_sessions", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[4]},
+			},
+		},
+	}
+	// SlugAliasColumns holds the columns for the "slug_alias" table.
+	SlugAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "blog_post_slug_aliases", Type: field.TypeInt},
+	}
+	// SlugAliasTable holds the schema information for the "slug_alias" table.
+	SlugAliasTable = &schema.Table{
+		Name:       "slug_alias",
+		Columns:    SlugAliasColumns,
+		PrimaryKey: []*schema.Column{SlugAliasColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "slug_alias_blog_posts_slug_aliases",
+				Columns:    []*schema.Column{SlugAliasColumns[3]},
+				RefColumns: []*schema.Column{BlogPostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "editor", "author", "reader"}, Default: "reader"},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// TagPostsColumns holds the columns for the "tag_posts" table.
+	TagPostsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeInt},
+		{Name: "blog_post_id", Type: field.TypeInt},
+	}
+	// TagPostsTable holds the schema information for the "tag_posts" table.
+	TagPostsTable = &schema.Table{
+		Name:       "tag_posts",
+		Columns:    TagPostsColumns,
+		PrimaryKey: []*schema.Column{TagPostsColumns[0], TagPostsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_posts_tag_id",
+				Columns:    []*schema.Column{TagPostsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_posts_blog_post_id",
+				Columns:    []*schema.Column{TagPostsColumns[1]},
+				RefColumns: []*schema.Column{BlogPostsColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuthorsTable,
 		BlogPostsTable,
+		CategoriesTable,
+		MediaTable,
+		PostRevisionsTable,
+		SessionsTable,
+		SlugAliasTable,
+		TagsTable,
+		UsersTable,
+		TagPostsTable,
 	}
 )
 
 func init() {
+	AuthorsTable.ForeignKeys[0].RefTable = UsersTable
+	BlogPostsTable.ForeignKeys[0].RefTable = AuthorsTable
+	BlogPostsTable.ForeignKeys[1].RefTable = CategoriesTable
+	BlogPostsTable.ForeignKeys[2].RefTable = MediaTable
+	BlogPostsTable.ForeignKeys[3].RefTable = UsersTable
+	CategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
+	MediaTable.ForeignKeys[0].RefTable = UsersTable
+	PostRevisionsTable.ForeignKeys[0].RefTable = BlogPostsTable
+	PostRevisionsTable.ForeignKeys[1].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	SlugAliasTable.ForeignKeys[0].RefTable = BlogPostsTable
+	TagPostsTable.ForeignKeys[0].RefTable = TagsTable
+	TagPostsTable.ForeignKeys[1].RefTable = BlogPostsTable
 }
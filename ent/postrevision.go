@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/postrevision"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+)
+
+// PostRevision is the model entity for the PostRevision schema.
+type PostRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Number holds the value of the "number" field.
+	Number int `json:"number,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Excerpt holds the value of the "excerpt" field.
+	Excerpt string `json:"excerpt,omitempty"`
+	// ExcerptGenerated holds the value of the "excerpt_generated" field.
+	ExcerptGenerated bool `json:"excerpt_generated,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// ContentFormat holds the value of the "content_format" field.
+	ContentFormat postrevision.ContentFormat `json:"content_format,omitempty"`
+	// RestoredFrom holds the value of the "restored_from" field.
+	RestoredFrom *int `json:"restored_from,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostRevisionQuery when eager-loading is set.
+	Edges               PostRevisionEdges `json:"edges"`
+	blog_post_revisions *int
+	user_revisions      *int
+	selectValues        sql.SelectValues
+}
+
+// PostRevisionEdges holds the relations/edges for other nodes in the graph.
+type PostRevisionEdges struct {
+	// Post holds the value of the post edge.
+	Post *BlogPost `json:"post,omitempty"`
+	// Editor holds the value of the editor edge.
+	Editor *User `json:"editor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostRevisionEdges) PostOrErr() (*BlogPost, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: blogpost.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// EditorOrErr returns the Editor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostRevisionEdges) EditorOrErr() (*User, error) {
+	if e.Editor != nil {
+		return e.Editor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "editor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PostRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case postrevision.FieldExcerptGenerated:
+			values[i] = new(sql.NullBool)
+		case postrevision.FieldID, postrevision.FieldNumber, postrevision.FieldRestoredFrom:
+			values[i] = new(sql.NullInt64)
+		case postrevision.FieldTitle, postrevision.FieldExcerpt, postrevision.FieldContent, postrevision.FieldContentFormat:
+			values[i] = new(sql.NullString)
+		case postrevision.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case postrevision.ForeignKeys[0]: // blog_post_revisions
+			values[i] = new(sql.NullInt64)
+		case postrevision.ForeignKeys[1]: // user_revisions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PostRevision fields.
+func (pr *PostRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case postrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case postrevision.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				pr.CreateTime = value.Time
+			}
+		case postrevision.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				pr.Number = int(value.Int64)
+			}
+		case postrevision.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				pr.Title = value.String
+			}
+		case postrevision.FieldExcerpt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field excerpt", values[i])
+			} else if value.Valid {
+				pr.Excerpt = value.String
+			}
+		case postrevision.FieldExcerptGenerated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field excerpt_generated", values[i])
+			} else if value.Valid {
+				pr.ExcerptGenerated = value.Bool
+			}
+		case postrevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				pr.Content = value.String
+			}
+		case postrevision.FieldContentFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_format", values[i])
+			} else if value.Valid {
+				pr.ContentFormat = postrevision.ContentFormat(value.String)
+			}
+		case postrevision.FieldRestoredFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_from", values[i])
+			} else if value.Valid {
+				pr.RestoredFrom = new(int)
+				*pr.RestoredFrom = int(value.Int64)
+			}
+		case postrevision.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field blog_post_revisions", value)
+			} else if value.Valid {
+				pr.blog_post_revisions = new(int)
+				*pr.blog_post_revisions = int(value.Int64)
+			}
+		case postrevision.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_revisions", value)
+			} else if value.Valid {
+				pr.user_revisions = new(int)
+				*pr.user_revisions = int(value.Int64)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PostRevision.
+// This includes values selected through modifiers, order, etc.
+func (pr *PostRevision) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the PostRevision entity.
+func (pr *PostRevision) QueryPost() *BlogPostQuery {
+	return NewPostRevisionClient(pr.config).QueryPost(pr)
+}
+
+// QueryEditor queries the "editor" edge of the PostRevision entity.
+func (pr *PostRevision) QueryEditor() *UserQuery {
+	return NewPostRevisionClient(pr.config).QueryEditor(pr)
+}
+
+// Update returns a builder for updating this PostRevision.
+// Note that you need to call PostRevision.Unwrap() before calling this method if this PostRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PostRevision) Update() *PostRevisionUpdateOne {
+	return NewPostRevisionClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PostRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PostRevision) Unwrap() *PostRevision {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PostRevision is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PostRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("PostRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(pr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Number))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(pr.Title)
+	builder.WriteString(", ")
+	builder.WriteString("excerpt=")
+	builder.WriteString(pr.Excerpt)
+	builder.WriteString(", ")
+	builder.WriteString("excerpt_generated=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ExcerptGenerated))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(pr.Content)
+	builder.WriteString(", ")
+	builder.WriteString("content_format=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ContentFormat))
+	builder.WriteString(", ")
+	if v := pr.RestoredFrom; v != nil {
+		builder.WriteString("restored_from=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PostRevisions is a parsable slice of PostRevision.
+type PostRevisions []*PostRevision
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postrevision
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the postrevision type in the database.
+	Label = "post_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldExcerpt holds the string denoting the excerpt field in the database.
+	FieldExcerpt = "excerpt"
+	// FieldExcerptGenerated holds the string denoting the excerpt_generated field in the database.
+	FieldExcerptGenerated = "excerpt_generated"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldContentFormat holds the string denoting the content_format field in the database.
+	FieldContentFormat = "content_format"
+	// FieldRestoredFrom holds the string denoting the restored_from field in the database.
+	FieldRestoredFrom = "restored_from"
+	// EdgePost holds the string denoting the post edge name in mutations.
+	EdgePost = "post"
+	// EdgeEditor holds the string denoting the editor edge name in mutations.
+	EdgeEditor = "editor"
+	// Table holds the table name of the postrevision in the database.
+	Table = "post_revisions"
+	// PostTable is the table that holds the post relation/edge.
+	PostTable = "post_revisions"
+	// PostInverseTable is the table name for the BlogPost entity.
+	// It exists in this package in order to avoid circular dependency with the "blogpost" package.
+	PostInverseTable = "blog_posts"
+	// PostColumn is the table column denoting the post relation/edge.
+	PostColumn = "blog_post_revisions"
+	// EditorTable is the table that holds the editor relation/edge.
+	EditorTable = "post_revisions"
+	// EditorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	EditorInverseTable = "users"
+	// EditorColumn is the table column denoting the editor relation/edge.
+	EditorColumn = "user_revisions"
+)
+
+// Columns holds all SQL columns for postrevision fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldNumber,
+	FieldTitle,
+	FieldExcerpt,
+	FieldExcerptGenerated,
+	FieldContent,
+	FieldContentFormat,
+	FieldRestoredFrom,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "post_revisions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"blog_post_revisions",
+	"user_revisions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	NumberValidator func(int) error
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// DefaultExcerptGenerated holds the default value on creation for the "excerpt_generated" field.
+	DefaultExcerptGenerated bool
+	// ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	ContentValidator func(string) error
+)
+
+// ContentFormat defines the type for the "content_format" enum field.
+type ContentFormat string
+
+// ContentFormatMarkdown is the default value of the ContentFormat enum.
+const DefaultContentFormat = ContentFormatMarkdown
+
+// ContentFormat values.
+const (
+	ContentFormatMarkdown  ContentFormat = "markdown"
+	ContentFormatHTML      ContentFormat = "html"
+	ContentFormatPlaintext ContentFormat = "plaintext"
+)
+
+func (cf ContentFormat) String() string {
+	return string(cf)
+}
+
+// ContentFormatValidator is a validator for the "content_format" field enum values. It is called by the builders before save.
+func ContentFormatValidator(cf ContentFormat) error {
+	switch cf {
+	case ContentFormatMarkdown, ContentFormatHTML, ContentFormatPlaintext:
+		return nil
+	default:
+		return fmt.Errorf("postrevision: invalid enum value for content_format field: %q", cf)
+	}
+}
+
+// OrderOption defines the ordering options for the PostRevision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByExcerpt orders the results by the excerpt field.
+func ByExcerpt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExcerpt, opts...).ToFunc()
+}
+
+// ByExcerptGenerated orders the results by the excerpt_generated field.
+func ByExcerptGenerated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExcerptGenerated, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByContentFormat orders the results by the content_format field.
+func ByContentFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentFormat, opts...).ToFunc()
+}
+
+// ByRestoredFrom orders the results by the restored_from field.
+func ByRestoredFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestoredFrom, opts...).ToFunc()
+}
+
+// ByPostField orders the results by post field.
+func ByPostField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPostStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEditorField orders the results by editor field.
+func ByEditorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEditorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPostStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PostInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+	)
+}
+func newEditorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EditorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EditorTable, EditorColumn),
+	)
+}
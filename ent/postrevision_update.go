@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/postrevision"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+)
+
+// PostRevisionUpdate is the builder for updating PostRevision entities.
+type PostRevisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PostRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (pru *PostRevisionUpdate) Where(ps ...predicate.PostRevision) *PostRevisionUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (pru *PostRevisionUpdate) Mutation() *PostRevisionMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PostRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PostRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PostRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PostRevisionUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PostRevisionUpdate) check() error {
+	if pru.mutation.PostCleared() && len(pru.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pru *PostRevisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostRevisionUpdate {
+	pru.modifiers = append(pru.modifiers, modifiers...)
+	return pru
+}
+
+func (pru *PostRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pru.mutation.RestoredFromCleared() {
+		_spec.ClearField(postrevision.FieldRestoredFrom, field.TypeInt)
+	}
+	_spec.AddModifiers(pru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PostRevisionUpdateOne is the builder for updating a single PostRevision entity.
+type PostRevisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PostRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (pruo *PostRevisionUpdateOne) Mutation() *PostRevisionMutation {
+	return pruo.mutation
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (pruo *PostRevisionUpdateOne) Where(ps ...predicate.PostRevision) *PostRevisionUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PostRevisionUpdateOne) Select(field string, fields ...string) *PostRevisionUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PostRevision entity.
+func (pruo *PostRevisionUpdateOne) Save(ctx context.Context) (*PostRevision, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PostRevisionUpdateOne) SaveX(ctx context.Context) *PostRevision {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PostRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PostRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PostRevisionUpdateOne) check() error {
+	if pruo.mutation.PostCleared() && len(pruo.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pruo *PostRevisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostRevisionUpdateOne {
+	pruo.modifiers = append(pruo.modifiers, modifiers...)
+	return pruo
+}
+
+func (pruo *PostRevisionUpdateOne) sqlSave(ctx context.Context) (_node *PostRevision, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postrevision.FieldID)
+		for _, f := range fields {
+			if !postrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pruo.mutation.RestoredFromCleared() {
+		_spec.ClearField(postrevision.FieldRestoredFrom, field.TypeInt)
+	}
+	_spec.AddModifiers(pruo.modifiers...)
+	_node = &PostRevision{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
@@ -6,5 +6,29 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Author is the predicate function for author builders.
+type Author func(*sql.Selector)
+
 // BlogPost is the predicate function for blogpost builders.
 type BlogPost func(*sql.Selector)
+
+// Category is the predicate function for category builders.
+type Category func(*sql.Selector)
+
+// Media is the predicate function for media builders.
+type Media func(*sql.Selector)
+
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// SlugAlias is the predicate function for slugalias builders.
+type SlugAlias func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
@@ -2,7 +2,289 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/AdongoJr2/technoprise-backend/ent/runtime.go
+import (
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/category"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/postrevision"
+	"github.com/AdongoJr2/technoprise-backend/ent/schema"
+	"github.com/AdongoJr2/technoprise-backend/ent/session"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+	"github.com/AdongoJr2/technoprise-backend/ent/tag"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	authorMixin := schema.Author{}.Mixin()
+	authorMixinFields0 := authorMixin[0].Fields()
+	_ = authorMixinFields0
+	authorFields := schema.Author{}.Fields()
+	_ = authorFields
+	// authorDescCreateTime is the schema descriptor for create_time field.
+	authorDescCreateTime := authorMixinFields0[0].Descriptor()
+	// author.DefaultCreateTime holds the default value on creation for the create_time field.
+	author.DefaultCreateTime = authorDescCreateTime.Default.(func() time.Time)
+	// authorDescUpdateTime is the schema descriptor for update_time field.
+	authorDescUpdateTime := authorMixinFields0[1].Descriptor()
+	// author.DefaultUpdateTime holds the default value on creation for the update_time field.
+	author.DefaultUpdateTime = authorDescUpdateTime.Default.(func() time.Time)
+	// author.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	author.UpdateDefaultUpdateTime = authorDescUpdateTime.UpdateDefault.(func() time.Time)
+	// authorDescName is the schema descriptor for name field.
+	authorDescName := authorFields[0].Descriptor()
+	// author.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	author.NameValidator = authorDescName.Validators[0].(func(string) error)
+	// authorDescSlug is the schema descriptor for slug field.
+	authorDescSlug := authorFields[1].Descriptor()
+	// author.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	author.SlugValidator = authorDescSlug.Validators[0].(func(string) error)
+	blogpostMixin := schema.BlogPost{}.Mixin()
+	blogpostMixinInters1 := blogpostMixin[1].Interceptors()
+	blogpost.Interceptors[0] = blogpostMixinInters1[0]
+	blogpostMixinFields0 := blogpostMixin[0].Fields()
+	_ = blogpostMixinFields0
+	blogpostFields := schema.BlogPost{}.Fields()
+	_ = blogpostFields
+	// blogpostDescCreateTime is the schema descriptor for create_time field.
+	blogpostDescCreateTime := blogpostMixinFields0[0].Descriptor()
+	// blogpost.DefaultCreateTime holds the default value on creation for the create_time field.
+	blogpost.DefaultCreateTime = blogpostDescCreateTime.Default.(func() time.Time)
+	// blogpostDescUpdateTime is the schema descriptor for update_time field.
+	blogpostDescUpdateTime := blogpostMixinFields0[1].Descriptor()
+	// blogpost.DefaultUpdateTime holds the default value on creation for the update_time field.
+	blogpost.DefaultUpdateTime = blogpostDescUpdateTime.Default.(func() time.Time)
+	// blogpost.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	blogpost.UpdateDefaultUpdateTime = blogpostDescUpdateTime.UpdateDefault.(func() time.Time)
+	// blogpostDescTitle is the schema descriptor for title field.
+	blogpostDescTitle := blogpostFields[0].Descriptor()
+	// blogpost.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	blogpost.TitleValidator = blogpostDescTitle.Validators[0].(func(string) error)
+	// blogpostDescSlug is the schema descriptor for slug field.
+	blogpostDescSlug := blogpostFields[1].Descriptor()
+	// blogpost.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	blogpost.SlugValidator = blogpostDescSlug.Validators[0].(func(string) error)
+	// blogpostDescContent is the schema descriptor for content field.
+	blogpostDescContent := blogpostFields[2].Descriptor()
+	// blogpost.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	blogpost.ContentValidator = blogpostDescContent.Validators[0].(func(string) error)
+	// blogpostDescWordCount is the schema descriptor for word_count field.
+	blogpostDescWordCount := blogpostFields[6].Descriptor()
+	// blogpost.DefaultWordCount holds the default value on creation for the word_count field.
+	blogpost.DefaultWordCount = blogpostDescWordCount.Default.(int)
+	// blogpost.WordCountValidator is a validator for the "word_count" field. It is called by the builders before save.
+	blogpost.WordCountValidator = blogpostDescWordCount.Validators[0].(func(int) error)
+	// blogpostDescReadingTime is the schema descriptor for reading_time field.
+	blogpostDescReadingTime := blogpostFields[7].Descriptor()
+	// blogpost.DefaultReadingTime holds the default value on creation for the reading_time field.
+	blogpost.DefaultReadingTime = blogpostDescReadingTime.Default.(int)
+	// blogpost.ReadingTimeValidator is a validator for the "reading_time" field. It is called by the builders before save.
+	blogpost.ReadingTimeValidator = blogpostDescReadingTime.Validators[0].(func(int) error)
+	// blogpostDescExcerpt is the schema descriptor for excerpt field.
+	blogpostDescExcerpt := blogpostFields[8].Descriptor()
+	// blogpost.ExcerptValidator is a validator for the "excerpt" field. It is called by the builders before save.
+	blogpost.ExcerptValidator = func() func(string) error {
+		validators := blogpostDescExcerpt.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(excerpt string) error {
+			for _, fn := range fns {
+				if err := fn(excerpt); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// blogpostDescExcerptGenerated is the schema descriptor for excerpt_generated field.
+	blogpostDescExcerptGenerated := blogpostFields[9].Descriptor()
+	// blogpost.DefaultExcerptGenerated holds the default value on creation for the excerpt_generated field.
+	blogpost.DefaultExcerptGenerated = blogpostDescExcerptGenerated.Default.(bool)
+	categoryMixin := schema.Category{}.Mixin()
+	categoryMixinFields0 := categoryMixin[0].Fields()
+	_ = categoryMixinFields0
+	categoryFields := schema.Category{}.Fields()
+	_ = categoryFields
+	// categoryDescCreateTime is the schema descriptor for create_time field.
+	categoryDescCreateTime := categoryMixinFields0[0].Descriptor()
+	// category.DefaultCreateTime holds the default value on creation for the create_time field.
+	category.DefaultCreateTime = categoryDescCreateTime.Default.(func() time.Time)
+	// categoryDescUpdateTime is the schema descriptor for update_time field.
+	categoryDescUpdateTime := categoryMixinFields0[1].Descriptor()
+	// category.DefaultUpdateTime holds the default value on creation for the update_time field.
+	category.DefaultUpdateTime = categoryDescUpdateTime.Default.(func() time.Time)
+	// category.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	category.UpdateDefaultUpdateTime = categoryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// categoryDescName is the schema descriptor for name field.
+	categoryDescName := categoryFields[0].Descriptor()
+	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
+	// categoryDescSlug is the schema descriptor for slug field.
+	categoryDescSlug := categoryFields[1].Descriptor()
+	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
+	mediaMixin := schema.Media{}.Mixin()
+	mediaMixinFields0 := mediaMixin[0].Fields()
+	_ = mediaMixinFields0
+	mediaFields := schema.Media{}.Fields()
+	_ = mediaFields
+	// mediaDescCreateTime is the schema descriptor for create_time field.
+	mediaDescCreateTime := mediaMixinFields0[0].Descriptor()
+	// media.DefaultCreateTime holds the default value on creation for the create_time field.
+	media.DefaultCreateTime = mediaDescCreateTime.Default.(func() time.Time)
+	// mediaDescUpdateTime is the schema descriptor for update_time field.
+	mediaDescUpdateTime := mediaMixinFields0[1].Descriptor()
+	// media.DefaultUpdateTime holds the default value on creation for the update_time field.
+	media.DefaultUpdateTime = mediaDescUpdateTime.Default.(func() time.Time)
+	// media.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	media.UpdateDefaultUpdateTime = mediaDescUpdateTime.UpdateDefault.(func() time.Time)
+	// mediaDescKey is the schema descriptor for key field.
+	mediaDescKey := mediaFields[0].Descriptor()
+	// media.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	media.KeyValidator = mediaDescKey.Validators[0].(func(string) error)
+	// mediaDescFormat is the schema descriptor for format field.
+	mediaDescFormat := mediaFields[2].Descriptor()
+	// media.FormatValidator is a validator for the "format" field. It is called by the builders before save.
+	media.FormatValidator = mediaDescFormat.Validators[0].(func(string) error)
+	// mediaDescMimeType is the schema descriptor for mime_type field.
+	mediaDescMimeType := mediaFields[3].Descriptor()
+	// media.MimeTypeValidator is a validator for the "mime_type" field. It is called by the builders before save.
+	media.MimeTypeValidator = mediaDescMimeType.Validators[0].(func(string) error)
+	// mediaDescWidth is the schema descriptor for width field.
+	mediaDescWidth := mediaFields[4].Descriptor()
+	// media.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	media.WidthValidator = mediaDescWidth.Validators[0].(func(int) error)
+	// mediaDescHeight is the schema descriptor for height field.
+	mediaDescHeight := mediaFields[5].Descriptor()
+	// media.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	media.HeightValidator = mediaDescHeight.Validators[0].(func(int) error)
+	// mediaDescSize is the schema descriptor for size field.
+	mediaDescSize := mediaFields[6].Descriptor()
+	// media.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	media.SizeValidator = mediaDescSize.Validators[0].(func(int64) error)
+	// mediaDescAltText is the schema descriptor for alt_text field.
+	mediaDescAltText := mediaFields[7].Descriptor()
+	// media.AltTextValidator is a validator for the "alt_text" field. It is called by the builders before save.
+	media.AltTextValidator = mediaDescAltText.Validators[0].(func(string) error)
+	// mediaDescPostUpload is the schema descriptor for post_upload field.
+	mediaDescPostUpload := mediaFields[10].Descriptor()
+	// media.DefaultPostUpload holds the default value on creation for the post_upload field.
+	media.DefaultPostUpload = mediaDescPostUpload.Default.(bool)
+	postrevisionMixin := schema.PostRevision{}.Mixin()
+	postrevisionMixinFields0 := postrevisionMixin[0].Fields()
+	_ = postrevisionMixinFields0
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescCreateTime is the schema descriptor for create_time field.
+	postrevisionDescCreateTime := postrevisionMixinFields0[0].Descriptor()
+	// postrevision.DefaultCreateTime holds the default value on creation for the create_time field.
+	postrevision.DefaultCreateTime = postrevisionDescCreateTime.Default.(func() time.Time)
+	// postrevisionDescNumber is the schema descriptor for number field.
+	postrevisionDescNumber := postrevisionFields[0].Descriptor()
+	// postrevision.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	postrevision.NumberValidator = postrevisionDescNumber.Validators[0].(func(int) error)
+	// postrevisionDescTitle is the schema descriptor for title field.
+	postrevisionDescTitle := postrevisionFields[1].Descriptor()
+	// postrevision.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	postrevision.TitleValidator = postrevisionDescTitle.Validators[0].(func(string) error)
+	// postrevisionDescExcerptGenerated is the schema descriptor for excerpt_generated field.
+	postrevisionDescExcerptGenerated := postrevisionFields[3].Descriptor()
+	// postrevision.DefaultExcerptGenerated holds the default value on creation for the excerpt_generated field.
+	postrevision.DefaultExcerptGenerated = postrevisionDescExcerptGenerated.Default.(bool)
+	// postrevisionDescContent is the schema descriptor for content field.
+	postrevisionDescContent := postrevisionFields[4].Descriptor()
+	// postrevision.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	postrevision.ContentValidator = postrevisionDescContent.Validators[0].(func(string) error)
+	sessionMixin := schema.Session{}.Mixin()
+	sessionMixinFields0 := sessionMixin[0].Fields()
+	_ = sessionMixinFields0
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreateTime is the schema descriptor for create_time field.
+	sessionDescCreateTime := sessionMixinFields0[0].Descriptor()
+	// session.DefaultCreateTime holds the default value on creation for the create_time field.
+	session.DefaultCreateTime = sessionDescCreateTime.Default.(func() time.Time)
+	// sessionDescUpdateTime is the schema descriptor for update_time field.
+	sessionDescUpdateTime := sessionMixinFields0[1].Descriptor()
+	// session.DefaultUpdateTime holds the default value on creation for the update_time field.
+	session.DefaultUpdateTime = sessionDescUpdateTime.Default.(func() time.Time)
+	// session.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	session.UpdateDefaultUpdateTime = sessionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// sessionDescTokenHash is the schema descriptor for token_hash field.
+	sessionDescTokenHash := sessionFields[0].Descriptor()
+	// session.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	session.TokenHashValidator = sessionDescTokenHash.Validators[0].(func(string) error)
+	slugaliasMixin := schema.SlugAlias{}.Mixin()
+	slugaliasMixinFields0 := slugaliasMixin[0].Fields()
+	_ = slugaliasMixinFields0
+	slugaliasFields := schema.SlugAlias{}.Fields()
+	_ = slugaliasFields
+	// slugaliasDescCreateTime is the schema descriptor for create_time field.
+	slugaliasDescCreateTime := slugaliasMixinFields0[0].Descriptor()
+	// slugalias.DefaultCreateTime holds the default value on creation for the create_time field.
+	slugalias.DefaultCreateTime = slugaliasDescCreateTime.Default.(func() time.Time)
+	// slugaliasDescSlug is the schema descriptor for slug field.
+	slugaliasDescSlug := slugaliasFields[0].Descriptor()
+	// slugalias.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	slugalias.SlugValidator = slugaliasDescSlug.Validators[0].(func(string) error)
+	tagMixin := schema.Tag{}.Mixin()
+	tagMixinFields0 := tagMixin[0].Fields()
+	_ = tagMixinFields0
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescCreateTime is the schema descriptor for create_time field.
+	tagDescCreateTime := tagMixinFields0[0].Descriptor()
+	// tag.DefaultCreateTime holds the default value on creation for the create_time field.
+	tag.DefaultCreateTime = tagDescCreateTime.Default.(func() time.Time)
+	// tagDescUpdateTime is the schema descriptor for update_time field.
+	tagDescUpdateTime := tagMixinFields0[1].Descriptor()
+	// tag.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tag.DefaultUpdateTime = tagDescUpdateTime.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tag.UpdateDefaultUpdateTime = tagDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescSlug is the schema descriptor for slug field.
+	tagDescSlug := tagFields[1].Descriptor()
+	// tag.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	tag.SlugValidator = tagDescSlug.Validators[0].(func(string) error)
+	userMixin := schema.User{}.Mixin()
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreateTime is the schema descriptor for create_time field.
+	userDescCreateTime := userMixinFields0[0].Descriptor()
+	// user.DefaultCreateTime holds the default value on creation for the create_time field.
+	user.DefaultCreateTime = userDescCreateTime.Default.(func() time.Time)
+	// userDescUpdateTime is the schema descriptor for update_time field.
+	userDescUpdateTime := userMixinFields0[1].Descriptor()
+	// user.DefaultUpdateTime holds the default value on creation for the update_time field.
+	user.DefaultUpdateTime = userDescUpdateTime.Default.(func() time.Time)
+	// user.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	user.UpdateDefaultUpdateTime = userDescUpdateTime.UpdateDefault.(func() time.Time)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[0].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[1].Descriptor()
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescPasswordHash is the schema descriptor for password_hash field.
+	userDescPasswordHash := userFields[2].Descriptor()
+	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
+}
 
 const (
 	Version = "v0.14.4"                                         // Version of ent codegen.
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Author holds the schema definition for the Author entity, the public
+// profile shown as a post's byline.
+type Author struct {
+	ent.Schema
+}
+
+// Fields of the Author.
+func (Author) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("slug").Unique().NotEmpty(),
+		field.Text("bio").Optional(),
+		field.String("avatar").Optional(),
+		field.JSON("social_links", map[string]string{}).Optional(),
+	}
+}
+
+// Edges of the Author.
+func (Author) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("posts", BlogPost.Type),
+		edge.From("user", User.Type).
+			Ref("author_profile").
+			Unique(),
+	}
+}
+
+// Mixin of the Author.
+func (Author) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -1,10 +1,16 @@
 package schema
 
 import (
+	"errors"
+	"unicode/utf8"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
 )
 
 // BlogPost holds the schema definition for the BlogPost entity.
@@ -18,21 +24,76 @@ func (BlogPost) Fields() []ent.Field {
 		field.String("title").NotEmpty(),
 		field.String("slug").Unique().NotEmpty(),
 		field.Text("content").NotEmpty(),
-		field.String("excerpt").MaxLen(160).NotEmpty(),
+		// content_format is how content is written. Markdown may embed HTML, so
+		// it also suits rows that predate the field.
+		field.Enum("content_format").
+			Values("markdown", "html", "plaintext").
+			Default("markdown"),
+		// content_html caches content rendered to sanitized HTML. It is written
+		// along with content; rows that predate it are rendered when read.
+		field.Text("content_html").Optional(),
+		// toc, word_count and reading_time (in minutes) are derived from
+		// content_html and written along with it.
+		field.JSON("toc", []schematype.TOCEntry{}).Optional(),
+		field.Int("word_count").NonNegative().Default(0),
+		field.Int("reading_time").NonNegative().Default(0),
+		// excerpt is limited in characters rather than bytes, as MaxLen would,
+		// so excerpts in non-Latin scripts get the same room.
+		field.String("excerpt").
+			NotEmpty().
+			Validate(func(s string) error {
+				if utf8.RuneCountInString(s) > 160 {
+					return errors.New("value is more than the max length")
+				}
+				return nil
+			}).
+			Annotations(entsql.Annotation{Size: 160}),
+		// excerpt_generated records that excerpt was derived from content, so
+		// it follows content edits until an author writes one.
+		field.Bool("excerpt_generated").Default(false),
 		field.String("image").Optional(),
+		// Resized renditions of image, smallest first.
+		field.JSON("image_variants", []schematype.ImageVariant{}).Optional(),
 		field.Time("published_at").Optional(),
+		// Existing rows predate the workflow and were already public, so the
+		// column defaults to published; the service sets it explicitly on create.
+		field.Enum("status").
+			Values("draft", "scheduled", "published", "archived").
+			Default("published"),
 	}
 }
 
 // Edges of the BlogPost.
 func (BlogPost) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("author", User.Type).
+			Ref("posts").
+			Unique(),
+		edge.From("byline", Author.Type).
+			Ref("posts").
+			Unique(),
+		edge.From("category", Category.Type).
+			Ref("posts").
+			Unique(),
+		edge.From("tags", Tag.Type).
+			Ref("posts"),
+		// media records the intrinsic dimensions and format of image.
+		edge.From("media", Media.Type).
+			Ref("posts").
+			Unique(),
+		// revisions and slug_aliases are removed with the post.
+		edge.To("revisions", PostRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("slug_aliases", SlugAlias.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
 }
 
 // Mixin of the BlogPost.
 func (BlogPost) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.Time{},
+		SoftDeleteMixin{},
 	}
 }
 
@@ -40,5 +101,8 @@ func (BlogPost) Mixin() []ent.Mixin {
 func (BlogPost) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("slug"),
+		index.Fields("status", "published_at"),
+		// Keyset pagination walks (create_time, id)
+		index.Fields("create_time", "id"),
 	}
 }
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+)
+
+// Media holds the schema definition for the Media entity: an uploaded file in
+// the media library, recorded after its metadata was stripped.
+type Media struct {
+	ent.Schema
+}
+
+// Fields of the Media.
+func (Media) Fields() []ent.Field {
+	return []ent.Field{
+		// Key is the file's storage key.
+		field.String("key").Unique().NotEmpty().Immutable(),
+		// Filename is the name the file was uploaded with, for display only.
+		field.String("filename").Optional(),
+		// Format is the detected image type: jpeg, png, gif, webp or avif.
+		field.String("format").NotEmpty(),
+		field.String("mime_type").NotEmpty(),
+		// Width and height are the intrinsic dimensions, after applying the EXIF orientation.
+		field.Int("width").Positive(),
+		field.Int("height").Positive(),
+		field.Int64("size").NonNegative(),
+		field.String("alt_text").MaxLen(300).Optional(),
+		field.Text("caption").Optional(),
+		// Resized renditions of the file, smallest first.
+		field.JSON("variants", []schematype.ImageVariant{}).Optional(),
+		// post_upload marks an entry created by uploading a post's image rather
+		// than through the media library. It is deleted with its files once no
+		// post or author uses it, unless it was edited in the library since.
+		field.Bool("post_upload").Default(false),
+	}
+}
+
+// Edges of the Media.
+func (Media) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("uploader", User.Type).
+			Ref("media").
+			Unique(),
+		edge.To("posts", BlogPost.Type),
+	}
+}
+
+// Mixin of the Media.
+func (Media) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// PostRevision holds the schema definition for the PostRevision entity: a
+// snapshot of a blog post's written fields, recorded with every change to them.
+type PostRevision struct {
+	ent.Schema
+}
+
+// Fields of the PostRevision.
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		// Number counts the post's revisions from 1.
+		field.Int("number").Positive().Immutable(),
+		field.String("title").NotEmpty().Immutable(),
+		field.String("excerpt").Immutable(),
+		field.Bool("excerpt_generated").Default(false).Immutable(),
+		field.Text("content").NotEmpty().Immutable(),
+		field.Enum("content_format").
+			Values("markdown", "html", "plaintext").
+			Default("markdown").
+			Immutable(),
+		// restored_from is the number of the revision this one restored, if any.
+		field.Int("restored_from").Optional().Nillable().Immutable(),
+	}
+}
+
+// Edges of the PostRevision.
+func (PostRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", BlogPost.Type).
+			Ref("revisions").
+			Unique().
+			Required().
+			Immutable(),
+		// editor is the user who made the change, unknown for revisions
+		// recorded from posts that predate revision history.
+		edge.From("editor", User.Type).
+			Ref("revisions").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Mixin of the PostRevision.
+func (PostRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+// Indexes of the PostRevision.
+func (PostRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("number").Edges("post").Unique(),
+	}
+}
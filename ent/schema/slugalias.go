@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SlugAlias holds the schema definition for the SlugAlias entity: a former
+// slug of a blog post, kept so that links to it redirect to the post.
+type SlugAlias struct {
+	ent.Schema
+}
+
+// Fields of the SlugAlias.
+func (SlugAlias) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("slug").Unique().NotEmpty().Immutable(),
+	}
+}
+
+// Edges of the SlugAlias.
+func (SlugAlias) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", BlogPost.Type).
+			Ref("slug_aliases").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin of the SlugAlias.
+func (SlugAlias) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/AdongoJr2/technoprise-backend/ent/intercept"
+)
+
+// SoftDeleteMixin adds a deleted_at field, set when a row is moved to the
+// trash, and hides trashed rows from every query unless the context says
+// otherwise. Rows are trashed by setting deleted_at rather than deleting them.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the SoftDeleteMixin.
+func (SoftDeleteMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
+	}
+}
+
+// softDeleteKey is the context key set by SkipSoftDelete.
+type softDeleteKey struct{}
+
+// SkipSoftDelete returns a copy of ctx whose queries also return trashed rows.
+func SkipSoftDelete(ctx context.Context) context.Context {
+	return context.WithValue(ctx, softDeleteKey{}, true)
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if skip, _ := ctx.Value(softDeleteKey{}).(bool); skip {
+				return nil
+			}
+			q.WhereP(sql.FieldIsNull("deleted_at"))
+			return nil
+		}),
+	}
+}
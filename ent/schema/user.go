@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").Unique().NotEmpty(),
+		field.String("name").NotEmpty(),
+		field.String("password_hash").Sensitive().NotEmpty(),
+		field.Enum("role").
+			Values("admin", "editor", "author", "reader").
+			Default("reader"),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("posts", BlogPost.Type),
+		edge.To("sessions", Session.Type),
+		edge.To("author_profile", Author.Type).
+			Unique(),
+		edge.To("media", Media.Type),
+		edge.To("revisions", PostRevision.Type),
+	}
+}
+
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
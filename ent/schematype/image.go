@@ -0,0 +1,16 @@
+// Package schematype holds the Go types of JSON fields in the ent schema. They
+// live outside the schema package so that the generated code, which the
+// schema's interceptors depend on, does not import the schema.
+package schematype
+
+// ImageVariant is a resized rendition of an uploaded image, stored next to the original.
+type ImageVariant struct {
+	Name string `json:"name"`
+	// Key is the variant's storage key.
+	Key string `json:"key,omitempty"`
+	// URL is the variant's public URL in API responses. Rows written before
+	// keys were stored hold it instead of Key until migrated.
+	URL    string `json:"url,omitempty"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
@@ -0,0 +1,10 @@
+package schematype
+
+// TOCEntry is a heading of a post's content, with the headings nested under it.
+type TOCEntry struct {
+	// ID is the anchor of the heading in the rendered content.
+	ID       string     `json:"id"`
+	Text     string     `json:"text"`
+	Level    int        `json:"level"`
+	Children []TOCEntry `json:"children,omitempty"`
+}
@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+)
+
+// SlugAlias is the model entity for the SlugAlias schema.
+type SlugAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SlugAliasQuery when eager-loading is set.
+	Edges                  SlugAliasEdges `json:"edges"`
+	blog_post_slug_aliases *int
+	selectValues           sql.SelectValues
+}
+
+// SlugAliasEdges holds the relations/edges for other nodes in the graph.
+type SlugAliasEdges struct {
+	// Post holds the value of the post edge.
+	Post *BlogPost `json:"post,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SlugAliasEdges) PostOrErr() (*BlogPost, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: blogpost.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SlugAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case slugalias.FieldID:
+			values[i] = new(sql.NullInt64)
+		case slugalias.FieldSlug:
+			values[i] = new(sql.NullString)
+		case slugalias.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case slugalias.ForeignKeys[0]: // blog_post_slug_aliases
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SlugAlias fields.
+func (sa *SlugAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case slugalias.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sa.ID = int(value.Int64)
+		case slugalias.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				sa.CreateTime = value.Time
+			}
+		case slugalias.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				sa.Slug = value.String
+			}
+		case slugalias.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field blog_post_slug_aliases", value)
+			} else if value.Valid {
+				sa.blog_post_slug_aliases = new(int)
+				*sa.blog_post_slug_aliases = int(value.Int64)
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SlugAlias.
+// This includes values selected through modifiers, order, etc.
+func (sa *SlugAlias) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the SlugAlias entity.
+func (sa *SlugAlias) QueryPost() *BlogPostQuery {
+	return NewSlugAliasClient(sa.config).QueryPost(sa)
+}
+
+// Update returns a builder for updating this SlugAlias.
+// Note that you need to call SlugAlias.Unwrap() before calling this method if this SlugAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *SlugAlias) Update() *SlugAliasUpdateOne {
+	return NewSlugAliasClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the SlugAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *SlugAlias) Unwrap() *SlugAlias {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SlugAlias is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *SlugAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("SlugAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(sa.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(sa.Slug)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SlugAliasSlice is a parsable slice of SlugAlias.
+type SlugAliasSlice []*SlugAlias
@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+)
+
+// SlugAliasCreate is the builder for creating a SlugAlias entity.
+type SlugAliasCreate struct {
+	config
+	mutation *SlugAliasMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (sac *SlugAliasCreate) SetCreateTime(t time.Time) *SlugAliasCreate {
+	sac.mutation.SetCreateTime(t)
+	return sac
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (sac *SlugAliasCreate) SetNillableCreateTime(t *time.Time) *SlugAliasCreate {
+	if t != nil {
+		sac.SetCreateTime(*t)
+	}
+	return sac
+}
+
+// SetSlug sets the "slug" field.
+func (sac *SlugAliasCreate) SetSlug(s string) *SlugAliasCreate {
+	sac.mutation.SetSlug(s)
+	return sac
+}
+
+// SetPostID sets the "post" edge to the BlogPost entity by ID.
+func (sac *SlugAliasCreate) SetPostID(id int) *SlugAliasCreate {
+	sac.mutation.SetPostID(id)
+	return sac
+}
+
+// SetPost sets the "post" edge to the BlogPost entity.
+func (sac *SlugAliasCreate) SetPost(b *BlogPost) *SlugAliasCreate {
+	return sac.SetPostID(b.ID)
+}
+
+// Mutation returns the SlugAliasMutation object of the builder.
+func (sac *SlugAliasCreate) Mutation() *SlugAliasMutation {
+	return sac.mutation
+}
+
+// Save creates the SlugAlias in the database.
+func (sac *SlugAliasCreate) Save(ctx context.Context) (*SlugAlias, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *SlugAliasCreate) SaveX(ctx context.Context) *SlugAlias {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *SlugAliasCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *SlugAliasCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *SlugAliasCreate) defaults() {
+	if _, ok := sac.mutation.CreateTime(); !ok {
+		v := slugalias.DefaultCreateTime()
+		sac.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *SlugAliasCreate) check() error {
+	if _, ok := sac.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SlugAlias.create_time"`)}
+	}
+	if _, ok := sac.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "SlugAlias.slug"`)}
+	}
+	if v, ok := sac.mutation.Slug(); ok {
+		if err := slugalias.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "SlugAlias.slug": %w`, err)}
+		}
+	}
+	if len(sac.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "SlugAlias.post"`)}
+	}
+	return nil
+}
+
+func (sac *SlugAliasCreate) sqlSave(ctx context.Context) (*SlugAlias, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *SlugAliasCreate) createSpec() (*SlugAlias, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SlugAlias{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(slugalias.Table, sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt))
+	)
+	if value, ok := sac.mutation.CreateTime(); ok {
+		_spec.SetField(slugalias.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := sac.mutation.Slug(); ok {
+		_spec.SetField(slugalias.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if nodes := sac.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slugalias.PostTable,
+			Columns: []string{slugalias.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blog_post_slug_aliases = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SlugAliasCreateBulk is the builder for creating many SlugAlias entities in bulk.
+type SlugAliasCreateBulk struct {
+	config
+	err      error
+	builders []*SlugAliasCreate
+}
+
+// Save creates the SlugAlias entities in the database.
+func (sacb *SlugAliasCreateBulk) Save(ctx context.Context) ([]*SlugAlias, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*SlugAlias, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SlugAliasMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *SlugAliasCreateBulk) SaveX(ctx context.Context) []*SlugAlias {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *SlugAliasCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *SlugAliasCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+)
+
+// SlugAliasDelete is the builder for deleting a SlugAlias entity.
+type SlugAliasDelete struct {
+	config
+	hooks    []Hook
+	mutation *SlugAliasMutation
+}
+
+// Where appends a list predicates to the SlugAliasDelete builder.
+func (sad *SlugAliasDelete) Where(ps ...predicate.SlugAlias) *SlugAliasDelete {
+	sad.mutation.Where(ps...)
+	return sad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sad *SlugAliasDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sad.sqlExec, sad.mutation, sad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sad *SlugAliasDelete) ExecX(ctx context.Context) int {
+	n, err := sad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sad *SlugAliasDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(slugalias.Table, sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt))
+	if ps := sad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sad.mutation.done = true
+	return affected, err
+}
+
+// SlugAliasDeleteOne is the builder for deleting a single SlugAlias entity.
+type SlugAliasDeleteOne struct {
+	sad *SlugAliasDelete
+}
+
+// Where appends a list predicates to the SlugAliasDelete builder.
+func (sado *SlugAliasDeleteOne) Where(ps ...predicate.SlugAlias) *SlugAliasDeleteOne {
+	sado.sad.mutation.Where(ps...)
+	return sado
+}
+
+// Exec executes the deletion query.
+func (sado *SlugAliasDeleteOne) Exec(ctx context.Context) error {
+	n, err := sado.sad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{slugalias.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sado *SlugAliasDeleteOne) ExecX(ctx context.Context) {
+	if err := sado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
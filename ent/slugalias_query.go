@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+)
+
+// SlugAliasQuery is the builder for querying SlugAlias entities.
+type SlugAliasQuery struct {
+	config
+	ctx        *QueryContext
+	order      []slugalias.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SlugAlias
+	withPost   *BlogPostQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SlugAliasQuery builder.
+func (saq *SlugAliasQuery) Where(ps ...predicate.SlugAlias) *SlugAliasQuery {
+	saq.predicates = append(saq.predicates, ps...)
+	return saq
+}
+
+// Limit the number of records to be returned by this query.
+func (saq *SlugAliasQuery) Limit(limit int) *SlugAliasQuery {
+	saq.ctx.Limit = &limit
+	return saq
+}
+
+// Offset to start from.
+func (saq *SlugAliasQuery) Offset(offset int) *SlugAliasQuery {
+	saq.ctx.Offset = &offset
+	return saq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (saq *SlugAliasQuery) Unique(unique bool) *SlugAliasQuery {
+	saq.ctx.Unique = &unique
+	return saq
+}
+
+// Order specifies how the records should be ordered.
+func (saq *SlugAliasQuery) Order(o ...slugalias.OrderOption) *SlugAliasQuery {
+	saq.order = append(saq.order, o...)
+	return saq
+}
+
+// QueryPost chains the current query on the "post" edge.
+func (saq *SlugAliasQuery) QueryPost() *BlogPostQuery {
+	query := (&BlogPostClient{config: saq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slugalias.Table, slugalias.FieldID, selector),
+			sqlgraph.To(blogpost.Table, blogpost.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, slugalias.PostTable, slugalias.PostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SlugAlias entity from the query.
+// Returns a *NotFoundError when no SlugAlias was found.
+func (saq *SlugAliasQuery) First(ctx context.Context) (*SlugAlias, error) {
+	nodes, err := saq.Limit(1).All(setContextOp(ctx, saq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{slugalias.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (saq *SlugAliasQuery) FirstX(ctx context.Context) *SlugAlias {
+	node, err := saq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SlugAlias ID from the query.
+// Returns a *NotFoundError when no SlugAlias ID was found.
+func (saq *SlugAliasQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = saq.Limit(1).IDs(setContextOp(ctx, saq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{slugalias.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (saq *SlugAliasQuery) FirstIDX(ctx context.Context) int {
+	id, err := saq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SlugAlias entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SlugAlias entity is found.
+// Returns a *NotFoundError when no SlugAlias entities are found.
+func (saq *SlugAliasQuery) Only(ctx context.Context) (*SlugAlias, error) {
+	nodes, err := saq.Limit(2).All(setContextOp(ctx, saq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{slugalias.Label}
+	default:
+		return nil, &NotSingularError{slugalias.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (saq *SlugAliasQuery) OnlyX(ctx context.Context) *SlugAlias {
+	node, err := saq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SlugAlias ID in the query.
+// Returns a *NotSingularError when more than one SlugAlias ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (saq *SlugAliasQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = saq.Limit(2).IDs(setContextOp(ctx, saq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{slugalias.Label}
+	default:
+		err = &NotSingularError{slugalias.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (saq *SlugAliasQuery) OnlyIDX(ctx context.Context) int {
+	id, err := saq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SlugAliasSlice.
+func (saq *SlugAliasQuery) All(ctx context.Context) ([]*SlugAlias, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryAll)
+	if err := saq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SlugAlias, *SlugAliasQuery]()
+	return withInterceptors[[]*SlugAlias](ctx, saq, qr, saq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (saq *SlugAliasQuery) AllX(ctx context.Context) []*SlugAlias {
+	nodes, err := saq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SlugAlias IDs.
+func (saq *SlugAliasQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if saq.ctx.Unique == nil && saq.path != nil {
+		saq.Unique(true)
+	}
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryIDs)
+	if err = saq.Select(slugalias.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (saq *SlugAliasQuery) IDsX(ctx context.Context) []int {
+	ids, err := saq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (saq *SlugAliasQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryCount)
+	if err := saq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, saq, querierCount[*SlugAliasQuery](), saq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (saq *SlugAliasQuery) CountX(ctx context.Context) int {
+	count, err := saq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (saq *SlugAliasQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryExist)
+	switch _, err := saq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (saq *SlugAliasQuery) ExistX(ctx context.Context) bool {
+	exist, err := saq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SlugAliasQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (saq *SlugAliasQuery) Clone() *SlugAliasQuery {
+	if saq == nil {
+		return nil
+	}
+	return &SlugAliasQuery{
+		config:     saq.config,
+		ctx:        saq.ctx.Clone(),
+		order:      append([]slugalias.OrderOption{}, saq.order...),
+		inters:     append([]Interceptor{}, saq.inters...),
+		predicates: append([]predicate.SlugAlias{}, saq.predicates...),
+		withPost:   saq.withPost.Clone(),
+		// clone intermediate query.
+		sql:       saq.sql.Clone(),
+		path:      saq.path,
+		modifiers: append([]func(*sql.Selector){}, saq.modifiers...),
+	}
+}
+
+// WithPost tells the query-builder to eager-load the nodes that are connected to
+// the "post" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *SlugAliasQuery) WithPost(opts ...func(*BlogPostQuery)) *SlugAliasQuery {
+	query := (&BlogPostClient{config: saq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withPost = query
+	return saq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SlugAlias.Query().
+//		GroupBy(slugalias.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (saq *SlugAliasQuery) GroupBy(field string, fields ...string) *SlugAliasGroupBy {
+	saq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SlugAliasGroupBy{build: saq}
+	grbuild.flds = &saq.ctx.Fields
+	grbuild.label = slugalias.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.SlugAlias.Query().
+//		Select(slugalias.FieldCreateTime).
+//		Scan(ctx, &v)
+func (saq *SlugAliasQuery) Select(fields ...string) *SlugAliasSelect {
+	saq.ctx.Fields = append(saq.ctx.Fields, fields...)
+	sbuild := &SlugAliasSelect{SlugAliasQuery: saq}
+	sbuild.label = slugalias.Label
+	sbuild.flds, sbuild.scan = &saq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SlugAliasSelect configured with the given aggregations.
+func (saq *SlugAliasQuery) Aggregate(fns ...AggregateFunc) *SlugAliasSelect {
+	return saq.Select().Aggregate(fns...)
+}
+
+func (saq *SlugAliasQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range saq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, saq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range saq.ctx.Fields {
+		if !slugalias.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if saq.path != nil {
+		prev, err := saq.path(ctx)
+		if err != nil {
+			return err
+		}
+		saq.sql = prev
+	}
+	return nil
+}
+
+func (saq *SlugAliasQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SlugAlias, error) {
+	var (
+		nodes       = []*SlugAlias{}
+		withFKs     = saq.withFKs
+		_spec       = saq.querySpec()
+		loadedTypes = [1]bool{
+			saq.withPost != nil,
+		}
+	)
+	if saq.withPost != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, slugalias.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SlugAlias).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SlugAlias{config: saq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, saq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := saq.withPost; query != nil {
+		if err := saq.loadPost(ctx, query, nodes, nil,
+			func(n *SlugAlias, e *BlogPost) { n.Edges.Post = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (saq *SlugAliasQuery) loadPost(ctx context.Context, query *BlogPostQuery, nodes []*SlugAlias, init func(*SlugAlias), assign func(*SlugAlias, *BlogPost)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SlugAlias)
+	for i := range nodes {
+		if nodes[i].blog_post_slug_aliases == nil {
+			continue
+		}
+		fk := *nodes[i].blog_post_slug_aliases
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(blogpost.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blog_post_slug_aliases" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (saq *SlugAliasQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := saq.querySpec()
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	_spec.Node.Columns = saq.ctx.Fields
+	if len(saq.ctx.Fields) > 0 {
+		_spec.Unique = saq.ctx.Unique != nil && *saq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, saq.driver, _spec)
+}
+
+func (saq *SlugAliasQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(slugalias.Table, slugalias.Columns, sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt))
+	_spec.From = saq.sql
+	if unique := saq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if saq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := saq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, slugalias.FieldID)
+		for i := range fields {
+			if fields[i] != slugalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := saq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := saq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (saq *SlugAliasQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(saq.driver.Dialect())
+	t1 := builder.Table(slugalias.Table)
+	columns := saq.ctx.Fields
+	if len(columns) == 0 {
+		columns = slugalias.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if saq.sql != nil {
+		selector = saq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if saq.ctx.Unique != nil && *saq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range saq.modifiers {
+		m(selector)
+	}
+	for _, p := range saq.predicates {
+		p(selector)
+	}
+	for _, p := range saq.order {
+		p(selector)
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (saq *SlugAliasQuery) Modify(modifiers ...func(s *sql.Selector)) *SlugAliasSelect {
+	saq.modifiers = append(saq.modifiers, modifiers...)
+	return saq.Select()
+}
+
+// SlugAliasGroupBy is the group-by builder for SlugAlias entities.
+type SlugAliasGroupBy struct {
+	selector
+	build *SlugAliasQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sagb *SlugAliasGroupBy) Aggregate(fns ...AggregateFunc) *SlugAliasGroupBy {
+	sagb.fns = append(sagb.fns, fns...)
+	return sagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sagb *SlugAliasGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sagb.build.ctx, ent.OpQueryGroupBy)
+	if err := sagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SlugAliasQuery, *SlugAliasGroupBy](ctx, sagb.build, sagb, sagb.build.inters, v)
+}
+
+func (sagb *SlugAliasGroupBy) sqlScan(ctx context.Context, root *SlugAliasQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sagb.fns))
+	for _, fn := range sagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sagb.flds)+len(sagb.fns))
+		for _, f := range *sagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SlugAliasSelect is the builder for selecting fields of SlugAlias entities.
+type SlugAliasSelect struct {
+	*SlugAliasQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sas *SlugAliasSelect) Aggregate(fns ...AggregateFunc) *SlugAliasSelect {
+	sas.fns = append(sas.fns, fns...)
+	return sas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sas *SlugAliasSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sas.ctx, ent.OpQuerySelect)
+	if err := sas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SlugAliasQuery, *SlugAliasSelect](ctx, sas.SlugAliasQuery, sas, sas.inters, v)
+}
+
+func (sas *SlugAliasSelect) sqlScan(ctx context.Context, root *SlugAliasQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sas.fns))
+	for _, fn := range sas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sas *SlugAliasSelect) Modify(modifiers ...func(s *sql.Selector)) *SlugAliasSelect {
+	sas.modifiers = append(sas.modifiers, modifiers...)
+	return sas
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+)
+
+// SlugAliasUpdate is the builder for updating SlugAlias entities.
+type SlugAliasUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SlugAliasMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SlugAliasUpdate builder.
+func (sau *SlugAliasUpdate) Where(ps ...predicate.SlugAlias) *SlugAliasUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetPostID sets the "post" edge to the BlogPost entity by ID.
+func (sau *SlugAliasUpdate) SetPostID(id int) *SlugAliasUpdate {
+	sau.mutation.SetPostID(id)
+	return sau
+}
+
+// SetPost sets the "post" edge to the BlogPost entity.
+func (sau *SlugAliasUpdate) SetPost(b *BlogPost) *SlugAliasUpdate {
+	return sau.SetPostID(b.ID)
+}
+
+// Mutation returns the SlugAliasMutation object of the builder.
+func (sau *SlugAliasUpdate) Mutation() *SlugAliasMutation {
+	return sau.mutation
+}
+
+// ClearPost clears the "post" edge to the BlogPost entity.
+func (sau *SlugAliasUpdate) ClearPost() *SlugAliasUpdate {
+	sau.mutation.ClearPost()
+	return sau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *SlugAliasUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *SlugAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *SlugAliasUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *SlugAliasUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *SlugAliasUpdate) check() error {
+	if sau.mutation.PostCleared() && len(sau.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SlugAlias.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sau *SlugAliasUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SlugAliasUpdate {
+	sau.modifiers = append(sau.modifiers, modifiers...)
+	return sau
+}
+
+func (sau *SlugAliasUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slugalias.Table, slugalias.Columns, sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if sau.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slugalias.PostTable,
+			Columns: []string{slugalias.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slugalias.PostTable,
+			Columns: []string{slugalias.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slugalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// SlugAliasUpdateOne is the builder for updating a single SlugAlias entity.
+type SlugAliasUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SlugAliasMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPostID sets the "post" edge to the BlogPost entity by ID.
+func (sauo *SlugAliasUpdateOne) SetPostID(id int) *SlugAliasUpdateOne {
+	sauo.mutation.SetPostID(id)
+	return sauo
+}
+
+// SetPost sets the "post" edge to the BlogPost entity.
+func (sauo *SlugAliasUpdateOne) SetPost(b *BlogPost) *SlugAliasUpdateOne {
+	return sauo.SetPostID(b.ID)
+}
+
+// Mutation returns the SlugAliasMutation object of the builder.
+func (sauo *SlugAliasUpdateOne) Mutation() *SlugAliasMutation {
+	return sauo.mutation
+}
+
+// ClearPost clears the "post" edge to the BlogPost entity.
+func (sauo *SlugAliasUpdateOne) ClearPost() *SlugAliasUpdateOne {
+	sauo.mutation.ClearPost()
+	return sauo
+}
+
+// Where appends a list predicates to the SlugAliasUpdate builder.
+func (sauo *SlugAliasUpdateOne) Where(ps ...predicate.SlugAlias) *SlugAliasUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *SlugAliasUpdateOne) Select(field string, fields ...string) *SlugAliasUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated SlugAlias entity.
+func (sauo *SlugAliasUpdateOne) Save(ctx context.Context) (*SlugAlias, error) {
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *SlugAliasUpdateOne) SaveX(ctx context.Context) *SlugAlias {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *SlugAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *SlugAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *SlugAliasUpdateOne) check() error {
+	if sauo.mutation.PostCleared() && len(sauo.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SlugAlias.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sauo *SlugAliasUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SlugAliasUpdateOne {
+	sauo.modifiers = append(sauo.modifiers, modifiers...)
+	return sauo
+}
+
+func (sauo *SlugAliasUpdateOne) sqlSave(ctx context.Context) (_node *SlugAlias, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slugalias.Table, slugalias.Columns, sqlgraph.NewFieldSpec(slugalias.FieldID, field.TypeInt))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SlugAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, slugalias.FieldID)
+		for _, f := range fields {
+			if !slugalias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != slugalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if sauo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slugalias.PostTable,
+			Columns: []string{slugalias.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slugalias.PostTable,
+			Columns: []string{slugalias.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sauo.modifiers...)
+	_node = &SlugAlias{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slugalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Author is the client for interacting with the Author builders.
+	Author *AuthorClient
 	// BlogPost is the client for interacting with the BlogPost builders.
 	BlogPost *BlogPostClient
+	// Category is the client for interacting with the Category builders.
+	Category *CategoryClient
+	// Media is the client for interacting with the Media builders.
+	Media *MediaClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// SlugAlias is the client for interacting with the SlugAlias builders.
+	SlugAlias *SlugAliasClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +161,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Author = NewAuthorClient(tx.config)
 	tx.BlogPost = NewBlogPostClient(tx.config)
+	tx.Category = NewCategoryClient(tx.config)
+	tx.Media = NewMediaClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.SlugAlias = NewSlugAliasClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.User = NewUserClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +179,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: BlogPost.QueryXXX(), the query will be executed
+// applies a query, for example: Author.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/middleware"
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// AuthHandler handles HTTP requests for authentication and user management.
+type AuthHandler struct {
+	service *services.AuthService
+}
+
+// NewAuthHandler creates a new AuthHandler.
+func NewAuthHandler(service *services.AuthService) *AuthHandler {
+	return &AuthHandler{
+		service: service,
+	}
+}
+
+// loginRequest is the request body for Login.
+type loginRequest struct {
+	Email    string `json:"email" form:"email"`
+	Password string `json:"password" form:"password"`
+}
+
+// Register handles self-service sign-up. New accounts always get the reader role.
+// POST /auth/register
+func (h *AuthHandler) Register(c echo.Context) error {
+	var input services.CreateUserInput
+	if err := c.Bind(&input); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid request body", err)
+	}
+	input.Role = ""
+
+	u, err := h.service.CreateUser(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			return utils.NewHTTPError(http.StatusBadRequest, "Invalid user", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to register user", err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"message": "User registered successfully",
+		"data":    u,
+	})
+}
+
+// Login handles exchanging email and password for a session token.
+// POST /auth/login
+func (h *AuthHandler) Login(c echo.Context) error {
+	var input loginRequest
+	if err := c.Bind(&input); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid request body", err)
+	}
+	if input.Email == "" || input.Password == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Email and Password are required", nil)
+	}
+
+	result, err := h.service.Login(c.Request().Context(), input.Email, input.Password)
+	if err != nil {
+		if errors.Is(err, services.ErrUnauthorized) {
+			return utils.NewHTTPError(http.StatusUnauthorized, "Invalid email or password", nil)
+		}
+		log.Printf("Handler error logging in: %v", err)
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to log in", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Logged in successfully",
+		"data":    result,
+	})
+}
+
+// Logout handles revoking the current session token.
+// POST /auth/logout
+func (h *AuthHandler) Logout(c echo.Context) error {
+	if err := h.service.Logout(c.Request().Context(), middleware.BearerToken(c)); err != nil {
+		log.Printf("Handler error logging out: %v", err)
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to log out", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Logged out successfully",
+	})
+}
+
+// Me handles retrieving the authenticated user.
+// GET /auth/me
+func (h *AuthHandler) Me(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "User retrieved successfully",
+		"data":    middleware.CurrentUser(c),
+	})
+}
+
+// CreateUser handles creating a user with any role, for admins.
+// POST /users
+func (h *AuthHandler) CreateUser(c echo.Context) error {
+	var input services.CreateUserInput
+	if err := c.Bind(&input); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid request body", err)
+	}
+
+	u, err := h.service.CreateUser(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			return utils.NewHTTPError(http.StatusBadRequest, "Invalid user", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to create user", err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"message": "User created successfully",
+		"data":    u,
+	})
+}
+
+// GetUsers handles listing all users, for admins.
+// GET /users
+func (h *AuthHandler) GetUsers(c echo.Context) error {
+	users, err := h.service.GetUsers(c.Request().Context())
+	if err != nil {
+		log.Printf("Handler error getting users: %v", err)
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve users", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Users retrieved successfully",
+		"data":    users,
+	})
+}
@@ -0,0 +1,240 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// AuthorHandler handles HTTP requests for author profiles.
+type AuthorHandler struct {
+	service         *services.AuthorService
+	blogPostService *services.BlogPostService
+	imageService    *services.ImageService
+	pagination      Pagination
+}
+
+// NewAuthorHandler creates a new AuthorHandler.
+func NewAuthorHandler(service *services.AuthorService, blogPostService *services.BlogPostService, imageService *services.ImageService, pagination Pagination) *AuthorHandler {
+	return &AuthorHandler{
+		service:         service,
+		blogPostService: blogPostService,
+		imageService:    imageService,
+		pagination:      pagination,
+	}
+}
+
+// CreateAuthor handles the creation of an author profile with avatar upload.
+// POST /authors
+func (h *AuthorHandler) CreateAuthor(c echo.Context) error {
+	if err := c.Request().ParseMultipartForm(10 << 20); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Failed to parse form data", err)
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid form data", err)
+	}
+
+	input := services.CreateAuthorInput{
+		Slug: getFirstValue(form.Value["slug"]),
+		Bio:  getFirstValue(form.Value["bio"]),
+	}
+	if name := getFirstValue(form.Value["name"]); name != nil {
+		input.Name = *name
+	}
+	if input.Name == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Name is required", nil)
+	}
+
+	if input.SocialLinks, err = parseSocialLinks(form.Value["social_links"]); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "social_links must be a JSON object of strings", err)
+	}
+
+	if v := getFirstValue(form.Value["user_id"]); v != nil {
+		userID, err := strconv.Atoi(*v)
+		if err != nil {
+			return utils.NewHTTPError(http.StatusBadRequest, "user_id must be an integer", err)
+		}
+		input.UserID = &userID
+	}
+
+	if files := form.File["avatar"]; len(files) > 0 {
+		avatarKey, err := h.imageService.UploadImage(c, files[0], uploadMeta(c))
+		if err != nil {
+			return uploadError(err, "Failed to upload avatar")
+		}
+		input.Avatar = &avatarKey
+	}
+
+	a, err := h.service.CreateAuthor(c.Request().Context(), input)
+	if err != nil {
+		h.discardUpload(c.Request().Context(), input.Avatar)
+		if errors.Is(err, services.ErrInvalidInput) {
+			return utils.NewHTTPError(http.StatusBadRequest, "Invalid author", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to create author", err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"message": "Author created successfully",
+		"data":    a,
+	})
+}
+
+// UpdateAuthor handles a partial update of an author profile, optionally replacing the avatar.
+// PATCH /authors/:slug
+func (h *AuthorHandler) UpdateAuthor(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Slug is required", nil)
+	}
+
+	if err := c.Request().ParseMultipartForm(10 << 20); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Failed to parse form data", err)
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid form data", err)
+	}
+
+	input := services.UpdateAuthorInput{
+		Name:         getFirstValue(form.Value["name"]),
+		Bio:          getFirstValue(form.Value["bio"]),
+		RemoveAvatar: getBoolValue(form.Value["remove_avatar"]),
+	}
+	if input.Name != nil && *input.Name == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Name cannot be empty", nil)
+	}
+
+	if input.SocialLinks, err = parseSocialLinks(form.Value["social_links"]); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "social_links must be a JSON object of strings", err)
+	}
+
+	if files := form.File["avatar"]; len(files) > 0 {
+		avatarKey, err := h.imageService.UploadImage(c, files[0], uploadMeta(c))
+		if err != nil {
+			return uploadError(err, "Failed to upload avatar")
+		}
+		input.Avatar = &avatarKey
+	}
+
+	a, err := h.service.UpdateAuthor(c.Request().Context(), slug, input)
+	if err != nil {
+		h.discardUpload(c.Request().Context(), input.Avatar)
+		log.Printf("Handler error updating author: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to update author", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Author updated successfully",
+		"data":    a,
+	})
+}
+
+// GetAuthors handles retrieving a paginated list of authors.
+// GET /authors?page=<int>&limit=<int>
+func (h *AuthorHandler) GetAuthors(c echo.Context) error {
+	page, limit, err := h.pagination.parsePage(c)
+	if err != nil {
+		return err
+	}
+
+	paginatedAuthors, err := h.service.GetAuthors(c.Request().Context(), page, limit)
+	if err != nil {
+		log.Printf("Handler error getting authors: %v", err)
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve authors", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":    "Authors retrieved successfully",
+		"data":       paginatedAuthors.Data,
+		"pagination": paginatedAuthors.Pagination,
+	})
+}
+
+// GetAuthorBySlug handles retrieving a single author profile.
+// GET /authors/:slug
+func (h *AuthorHandler) GetAuthorBySlug(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Slug is required", nil)
+	}
+
+	a, err := h.service.GetAuthorBySlug(c.Request().Context(), slug)
+	if err != nil {
+		log.Printf("Handler error getting author by slug: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve author", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Author retrieved successfully",
+		"data":    a,
+	})
+}
+
+// GetAuthorPosts handles retrieving an author's published posts with pagination.
+// GET /authors/:slug/posts, accepting the GET /posts parameters
+func (h *AuthorHandler) GetAuthorPosts(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Slug is required", nil)
+	}
+
+	if _, err := h.service.GetAuthorBySlug(c.Request().Context(), slug); err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve author", err)
+	}
+
+	params, err := h.pagination.parseListParams(c)
+	if err != nil {
+		return err
+	}
+	params.AuthorSlug = slug
+
+	paginatedPosts, err := h.blogPostService.GetBlogPosts(c.Request().Context(), params)
+	if err != nil {
+		log.Printf("Handler error getting author posts: %v", err)
+		return listingError(err, "Failed to retrieve blog posts")
+	}
+
+	return c.JSON(http.StatusOK, blogPostsResponse(paginatedPosts))
+}
+
+// discardUpload deletes an avatar uploaded for a request that then failed.
+func (h *AuthorHandler) discardUpload(ctx context.Context, key *string) {
+	if key == nil {
+		return
+	}
+	if err := h.imageService.DeleteImage(ctx, *key); err != nil {
+		log.Printf("Handler error deleting unused image: %v", err)
+	}
+}
+
+// Helper function to decode an optional JSON object of social links
+func parseSocialLinks(values []string) (map[string]string, error) {
+	if len(values) == 0 || values[0] == "" {
+		return nil, nil
+	}
+	links := map[string]string{}
+	if err := json.Unmarshal([]byte(values[0]), &links); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
@@ -1,11 +1,21 @@
 package controllers
 
 import (
+	"errors"
+	"fmt"
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+	"github.com/AdongoJr2/technoprise-backend/internal/middleware"
 	"github.com/AdongoJr2/technoprise-backend/internal/services"
 	"github.com/AdongoJr2/technoprise-backend/internal/utils"
 	"log"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"path"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 )
@@ -14,13 +24,15 @@ import (
 type BlogPostHandler struct {
 	service      *services.BlogPostService
 	imageService *services.ImageService
+	pagination   Pagination
 }
 
 // NewBlogPostHandler creates a new BlogPostHandler.
-func NewBlogPostHandler(service *services.BlogPostService, imageService *services.ImageService) *BlogPostHandler {
+func NewBlogPostHandler(service *services.BlogPostService, imageService *services.ImageService, pagination Pagination) *BlogPostHandler {
 	return &BlogPostHandler{
 		service:      service,
 		imageService: imageService,
+		pagination:   pagination,
 	}
 }
 
@@ -37,38 +49,118 @@ func (h *BlogPostHandler) CreateBlogPost(c echo.Context) error {
 	}
 
 	input := services.CreateBlogPostInput{
-		Title:       form.Value["title"][0],
-		Excerpt:     form.Value["excerpt"][0],
-		Content:     form.Value["content"][0],
-		PublishedAt: getFirstValue(form.Value["published_at"]),
-		Slug:        getFirstValue(form.Value["slug"]),
+		Title:         c.FormValue("title"),
+		Excerpt:       c.FormValue("excerpt"),
+		Content:       c.FormValue("content"),
+		ContentFormat: getFirstValue(form.Value["content_format"]),
+		PublishedAt:   getFirstValue(form.Value["published_at"]),
+		Slug:          getFirstValue(form.Value["slug"]),
+		Status:        getFirstValue(form.Value["status"]),
+		AuthorSlug:    getFirstValue(form.Value["author"]),
+		CategorySlug:  getFirstValue(form.Value["category"]),
+		TagSlugs:      getListValue(form.Value["tags"]),
 	}
 
-	if files := form.File["image"]; len(files) > 0 {
-		imageURL, err := h.imageService.UploadImage(c, files[0])
-		if err != nil {
-			return utils.NewHTTPError(http.StatusInternalServerError, "Failed to upload image", err)
-		}
-		input.Image = &imageURL
+	if u := middleware.CurrentUser(c); u != nil {
+		input.AuthorID = &u.ID
+	}
+
+	// Authors submit drafts; editors publish and schedule them
+	publishing := (input.Status != nil && *input.Status != "" && *input.Status != string(blogpost.StatusDraft)) ||
+		(input.PublishedAt != nil && *input.PublishedAt != "")
+	if publishing && !canPublish(c) {
+		return utils.NewHTTPError(http.StatusForbidden, publishForbiddenMessage, nil)
+	}
+
+	// Validate required fields; the excerpt is derived from the content when missing
+	var fieldErrs []utils.FieldError
+	if input.Title == "" {
+		fieldErrs = append(fieldErrs, utils.FieldError{Field: "title", Message: "is required"})
+	}
+	if input.Content == "" {
+		fieldErrs = append(fieldErrs, utils.FieldError{Field: "content", Message: "is required"})
+	}
+	if len(fieldErrs) > 0 {
+		return utils.NewValidationError(invalidPostMessage, fieldErrs)
 	}
 
-	// Validate required fields
-	if input.Title == "" || input.Content == "" || input.Excerpt == "" {
-		return utils.NewHTTPError(http.StatusBadRequest, "Title, Content and Excerpt are required", nil)
+	img, uploaded, err := h.postImage(c, form)
+	if err != nil {
+		return err
+	}
+	if img != nil {
+		input.Image, input.ImageVariants, input.MediaID = &img.Key, img.Variants, &img.MediaID
 	}
 
 	// Create post
 	post, err := h.service.CreateBlogPost(c.Request().Context(), input)
 	if err != nil {
+		// Don't leave the upload behind if the post wasn't created
+		if uploaded {
+			delErr := h.imageService.DeleteResponsiveImage(c.Request().Context(), *input.Image, input.ImageVariants)
+			if delErr != nil {
+				log.Printf("Handler error deleting unused image: %v", delErr)
+			}
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			return invalidPostError(err)
+		}
 		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to create blog post", err)
 	}
 
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"message": "Blog post created successfully",
-		"data":    post,
+		"data":    services.NewBlogPostView(post),
 	})
 }
 
+// postImage returns the image a post form sets: a new upload in the "image" field,
+// or an existing media library entry in "media_id". uploaded reports whether a
+// file was stored, so it can be discarded if the post is not saved.
+func (h *BlogPostHandler) postImage(c echo.Context, form *multipart.Form) (img *services.ResponsiveImage, uploaded bool, err error) {
+	files, mediaID := form.File["image"], getFirstValue(form.Value["media_id"])
+	switch {
+	case len(files) > 0 && mediaID != nil:
+		return nil, false, utils.NewHTTPError(http.StatusBadRequest, "Send either an image or a media_id, not both", nil)
+	case len(files) > 0:
+		meta := uploadMeta(c)
+		meta.PostUpload = true
+		img, err = h.imageService.UploadResponsiveImage(c, files[0], meta)
+		if err != nil {
+			return nil, false, uploadError(err, "Failed to upload image")
+		}
+		return img, true, nil
+	case mediaID != nil:
+		id, convErr := strconv.Atoi(*mediaID)
+		if convErr != nil {
+			return nil, false, utils.NewHTTPError(http.StatusBadRequest, "media_id must be an integer", convErr)
+		}
+		img, err = h.imageService.MediaImage(c.Request().Context(), id)
+		if err != nil {
+			if errors.Is(err, services.ErrNotFound) {
+				return nil, false, utils.NewHTTPError(http.StatusBadRequest, invalidPostMessage, fmt.Errorf("%w: %v", services.ErrInvalidInput, err))
+			}
+			return nil, false, utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve media", err)
+		}
+	}
+	return img, false, nil
+}
+
+// invalidPostMessage is the message of every rejected post create or update.
+const invalidPostMessage = "Invalid blog post"
+
+// invalidPostError returns the 400 error of a post the service rejected,
+// listing the rejected field when it is known.
+func invalidPostError(err error) error {
+	var paramErr *services.ParamError
+	if errors.As(err, &paramErr) {
+		return utils.NewValidationError(invalidPostMessage, []utils.FieldError{
+			{Field: paramErr.Param, Message: paramErr.Message},
+		})
+	}
+	return utils.NewHTTPError(http.StatusBadRequest, invalidPostMessage, err)
+}
+
 // Helper function to safely get first value from form array
 func getFirstValue(values []string) *string {
 	if len(values) > 0 {
@@ -77,32 +169,88 @@ func getFirstValue(values []string) *string {
 	return nil
 }
 
-// GetBlogPosts handles retrieving a list of blog posts with pagination and search.
-// GET /posts?page=<int>&limit=<int>&search=<string>
-func (h *BlogPostHandler) GetBlogPosts(c echo.Context) error {
-	page, err := strconv.Atoi(c.QueryParam("page"))
-	if err != nil || page < 1 {
-		page = 1
+// publishForbiddenMessage is the message of a status or publish date set by a user who may not publish.
+const publishForbiddenMessage = "Only editors can publish or schedule blog posts"
+
+// canPublish reports whether the authenticated user may set a post's status or publish date.
+func canPublish(c echo.Context) bool {
+	u := middleware.CurrentUser(c)
+	return u != nil && (u.Role == user.RoleAdmin || u.Role == user.RoleEditor)
+}
+
+// authorizePost checks that the authenticated user may manage the post identified by slug.
+func (h *BlogPostHandler) authorizePost(c echo.Context, slug string) error {
+	err := h.service.AuthorizePostAccess(c.Request().Context(), slug, middleware.CurrentUser(c))
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, services.ErrNotFound) {
+		return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+	}
+	if errors.Is(err, services.ErrForbidden) {
+		return utils.NewHTTPError(http.StatusForbidden, "You do not have permission to manage this blog post", nil)
+	}
+	log.Printf("Handler error authorizing blog post access: %v", err)
+	return utils.NewHTTPError(http.StatusInternalServerError, "Failed to authorize request", err)
+}
+
+// blogPostsResponse builds the listing envelope. "pagination" is only
+// present in page mode; "cursors" is always present.
+func blogPostsResponse(paginatedPosts *services.PaginatedBlogPosts) map[string]interface{} {
+	response := map[string]interface{}{
+		"message": "Blog posts retrieved successfully",
+		"data":    paginatedPosts.Data,
+		"cursors": paginatedPosts.Cursors,
+	}
+	if paginatedPosts.Pagination != nil {
+		response["pagination"] = paginatedPosts.Pagination
 	}
+	return response
+}
 
-	limit, err := strconv.Atoi(c.QueryParam("limit"))
-	if err != nil || limit < 1 {
-		limit = 10
+// Helper function to collect comma-separated and repeated values into one list.
+// Returns nil when the field was not sent, and an empty list when it was sent blank.
+func getListValue(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	list := []string{}
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
 	}
+	return list
+}
 
-	searchTerm := c.QueryParam("search")
+// Helper function to parse an optional boolean form value, defaulting to false
+func getBoolValue(values []string) bool {
+	if len(values) == 0 {
+		return false
+	}
+	b, err := strconv.ParseBool(values[0])
+	return err == nil && b
+}
 
-	paginatedPosts, err := h.service.GetBlogPosts(c.Request().Context(), page, limit, searchTerm)
+// GetBlogPosts handles retrieving a list of blog posts with pagination, search, filters and sorting.
+// GET /posts?page=<int>&limit=<int>&after=<cursor>&before=<cursor>&search=<string>&category=<slug>&tag=<slug>[,<slug>...]
+// &sort=<[-]field>[,...]&fields=<field>[,...]&published_from=<date>&published_to=<date>
+// &created_from=<date>&created_to=<date>&has_image=<bool>
+func (h *BlogPostHandler) GetBlogPosts(c echo.Context) error {
+	params, err := h.pagination.parseListParams(c)
+	if err != nil {
+		return err
+	}
+
+	paginatedPosts, err := h.service.GetBlogPosts(c.Request().Context(), params)
 	if err != nil {
 		log.Printf("Handler error getting blog posts: %v", err)
-		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve blog posts", err)
+		return listingError(err, "Failed to retrieve blog posts")
 	}
 
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message":    "Blog posts retrieved successfully",
-		"data":       paginatedPosts.Data,
-		"pagination": paginatedPosts.Pagination,
-	})
+	return c.JSON(http.StatusOK, blogPostsResponse(paginatedPosts))
 }
 
 // GetBlogPostBySlug handles retrieving a single blog post by its slug.
@@ -113,17 +261,301 @@ func (h *BlogPostHandler) GetBlogPostBySlug(c echo.Context) error {
 		return utils.NewHTTPError(http.StatusBadRequest, "Slug is required", nil)
 	}
 
+	mode, err := services.ParseContentMode(c.QueryParam("content"))
+	if err != nil {
+		return listingError(err, "Failed to retrieve blog post")
+	}
+
 	post, err := h.service.GetBlogPostBySlug(c.Request().Context(), slug)
 	if err != nil {
+		var moved *services.SlugMovedError
+		if errors.As(err, &moved) {
+			return postMovedResponse(c, moved)
+		}
 		log.Printf("Handler error getting blog post by slug: %v", err)
-		if err.Error() == "blog post with slug '"+slug+"' not found" {
+		if errors.Is(err, services.ErrNotFound) {
 			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
 		}
 		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve blog post", err)
 	}
 
+	return h.postResponse(c, post, mode)
+}
+
+// UpdateBlogPost handles a partial update of a blog post, optionally replacing its image.
+// PATCH /posts/:slug
+func (h *BlogPostHandler) UpdateBlogPost(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Slug is required", nil)
+	}
+
+	if err := h.authorizePost(c, slug); err != nil {
+		return err
+	}
+
+	if err := c.Request().ParseMultipartForm(10 << 20); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Failed to parse form data", err)
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid form data", err)
+	}
+
+	input := services.UpdateBlogPostInput{
+		Title:          getFirstValue(form.Value["title"]),
+		Excerpt:        getFirstValue(form.Value["excerpt"]),
+		Content:        getFirstValue(form.Value["content"]),
+		ContentFormat:  getFirstValue(form.Value["content_format"]),
+		PublishedAt:    getFirstValue(form.Value["published_at"]),
+		Slug:           getFirstValue(form.Value["slug"]),
+		RemoveImage:    getBoolValue(form.Value["remove_image"]),
+		RegenerateSlug: getBoolValue(form.Value["regenerate_slug"]),
+		AuthorSlug:     getFirstValue(form.Value["author"]),
+		CategorySlug:   getFirstValue(form.Value["category"]),
+		TagSlugs:       getListValue(form.Value["tags"]),
+	}
+	if u := middleware.CurrentUser(c); u != nil {
+		input.EditorID = &u.ID
+	}
+	if input.PublishedAt != nil && !canPublish(c) {
+		return utils.NewHTTPError(http.StatusForbidden, publishForbiddenMessage, nil)
+	}
+
+	// Validate provided fields; an empty excerpt is derived from the content
+	var fieldErrs []utils.FieldError
+	if input.Title != nil && *input.Title == "" {
+		fieldErrs = append(fieldErrs, utils.FieldError{Field: "title", Message: "cannot be empty"})
+	}
+	if input.Content != nil && *input.Content == "" {
+		fieldErrs = append(fieldErrs, utils.FieldError{Field: "content", Message: "cannot be empty"})
+	}
+	if len(fieldErrs) > 0 {
+		return utils.NewValidationError(invalidPostMessage, fieldErrs)
+	}
+
+	img, uploaded, err := h.postImage(c, form)
+	if err != nil {
+		return err
+	}
+	if img != nil {
+		input.Image, input.ImageVariants, input.MediaID = &img.Key, img.Variants, &img.MediaID
+	}
+
+	post, err := h.service.UpdateBlogPost(c.Request().Context(), slug, input)
+	if err != nil {
+		// Don't leave the new upload behind if the post wasn't updated
+		if uploaded {
+			delErr := h.imageService.DeleteResponsiveImage(c.Request().Context(), *input.Image, input.ImageVariants)
+			if delErr != nil {
+				log.Printf("Handler error deleting unused image: %v", delErr)
+			}
+		}
+		log.Printf("Handler error updating blog post: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			return invalidPostError(err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to update blog post", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Blog post updated successfully",
+		"data":    services.NewBlogPostView(post),
+	})
+}
+
+// DeleteBlogPost handles moving a blog post to the trash.
+// DELETE /posts/:slug
+func (h *BlogPostHandler) DeleteBlogPost(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Slug is required", nil)
+	}
+
+	if err := h.authorizePost(c, slug); err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteBlogPost(c.Request().Context(), slug); err != nil {
+		log.Printf("Handler error deleting blog post: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to delete blog post", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Blog post moved to the trash",
+	})
+}
+
+// RestoreBlogPost handles taking a blog post out of the trash.
+// POST /posts/:slug/restore
+func (h *BlogPostHandler) RestoreBlogPost(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Slug is required", nil)
+	}
+
+	if err := h.authorizePost(c, slug); err != nil {
+		return err
+	}
+
+	post, err := h.service.RestoreBlogPost(c.Request().Context(), slug)
+	if err != nil {
+		log.Printf("Handler error restoring blog post: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to restore blog post", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Blog post restored successfully",
+		"data":    services.NewBlogPostView(post),
+	})
+}
+
+// GetEditorBlogPosts handles listing blog posts of any status for editors.
+// GET /editor/posts?status=<draft|scheduled|published|archived> plus the GET /posts parameters
+func (h *BlogPostHandler) GetEditorBlogPosts(c echo.Context) error {
+	return h.editorBlogPosts(c, false)
+}
+
+// GetTrash handles listing the blog posts in the trash.
+// GET /editor/trash with the GET /editor/posts parameters
+func (h *BlogPostHandler) GetTrash(c echo.Context) error {
+	return h.editorBlogPosts(c, true)
+}
+
+// editorBlogPosts lists the blog posts of any status, either in the trash or not.
+func (h *BlogPostHandler) editorBlogPosts(c echo.Context, trashed bool) error {
+	params, err := h.pagination.parseListParams(c)
+	if err != nil {
+		return err
+	}
+	params.Status = c.QueryParam("status")
+	params.IncludeUnpublished = true
+	params.Trashed = trashed
+
+	// Authors only see their own posts
+	if u := middleware.CurrentUser(c); u != nil && u.Role == user.RoleAuthor {
+		params.AuthorID = u.ID
+	}
+
+	paginatedPosts, err := h.service.GetBlogPosts(c.Request().Context(), params)
+	if err != nil {
+		log.Printf("Handler error getting editor blog posts: %v", err)
+		return listingError(err, "Failed to retrieve blog posts")
+	}
+
+	return c.JSON(http.StatusOK, blogPostsResponse(paginatedPosts))
+}
+
+// PreviewBlogPost handles retrieving a blog post by slug regardless of its status.
+// GET /editor/posts/:slug
+func (h *BlogPostHandler) PreviewBlogPost(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Slug is required", nil)
+	}
+
+	mode, err := services.ParseContentMode(c.QueryParam("content"))
+	if err != nil {
+		return listingError(err, "Failed to retrieve blog post")
+	}
+
+	// Former slugs are resolved before authorizing, against the post they
+	// now redirect to
+	post, err := h.service.PreviewBlogPost(c.Request().Context(), slug)
+	if err != nil {
+		var moved *services.SlugMovedError
+		if errors.As(err, &moved) {
+			if err := h.authorizePost(c, moved.Slug); err != nil {
+				return err
+			}
+			return postMovedResponse(c, moved)
+		}
+		log.Printf("Handler error previewing blog post: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve blog post", err)
+	}
+
+	if err := h.authorizePost(c, post.Slug); err != nil {
+		return err
+	}
+	return h.postResponse(c, post, mode)
+}
+
+// postResponse writes a single retrieved post, with its content in the requested form.
+func (h *BlogPostHandler) postResponse(c echo.Context, post *ent.BlogPost, mode services.ContentMode) error {
+	view := services.NewBlogPostView(post)
+	if mode == services.ContentRendered {
+		view.RenderContent()
+	}
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"message": "Blog post retrieved successfully",
-		"data":    post,
+		"data":    view,
+	})
+}
+
+// postMovedResponse permanently redirects a request for a former slug of a post
+// to its current slug. The body repeats the new slug and location for API
+// clients that do not follow redirects.
+func postMovedResponse(c echo.Context, moved *services.SlugMovedError) error {
+	req := c.Request()
+	location := utils.PublicBaseURLFrom(req.Context()) + path.Dir(req.URL.Path) + "/" + url.PathEscape(moved.Slug)
+	if req.URL.RawQuery != "" {
+		location += "?" + req.URL.RawQuery
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, location)
+	return c.JSON(http.StatusMovedPermanently, map[string]interface{}{
+		"message": "Blog post has moved",
+		"data": map[string]string{
+			"slug":     moved.Slug,
+			"location": location,
+		},
+	})
+}
+
+// TransitionBlogPost handles moving a blog post between draft, scheduled, published and archived.
+// PUT /posts/:slug/status
+func (h *BlogPostHandler) TransitionBlogPost(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Slug is required", nil)
+	}
+
+	var input services.TransitionBlogPostInput
+	if err := c.Bind(&input); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid request body", err)
+	}
+	if input.Status == "" {
+		return utils.NewHTTPError(http.StatusBadRequest, "Status is required", nil)
+	}
+
+	post, err := h.service.TransitionBlogPost(c.Request().Context(), slug, input)
+	if err != nil {
+		log.Printf("Handler error changing blog post status: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			return utils.NewHTTPError(http.StatusUnprocessableEntity, "Invalid status change", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to change blog post status", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Blog post status updated successfully",
+		"data":    services.NewBlogPostView(post),
 	})
 }
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/enttest"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/storage"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestPreviewBlogPost(t *testing.T) {
+	ctx := context.Background()
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", name))
+	t.Cleanup(func() { client.Close() })
+
+	newUser := func(email string, role user.Role) *ent.User {
+		return client.User.Create().SetEmail(email).SetName(email).SetPasswordHash("x").SetRole(role).SaveX(ctx)
+	}
+	owner := newUser("owner@example.com", user.RoleAuthor)
+	other := newUser("other@example.com", user.RoleAuthor)
+	editor := newUser("editor@example.com", user.RoleEditor)
+
+	post := client.BlogPost.Create().
+		SetTitle("Draft").SetSlug("current").SetContent("x").SetExcerpt("x").SetAuthor(owner).
+		SaveX(ctx)
+	client.SlugAlias.Create().SetSlug("former").SetPost(post).SaveX(ctx)
+
+	store, err := storage.NewLocal(t.TempDir(), "http://localhost/images")
+	if err != nil {
+		t.Fatal(err)
+	}
+	images := services.NewImageService(client, store, services.ImageOptions{PublicPath: "/images"})
+	h := NewBlogPostHandler(services.NewBlogPostService(client, images), images, Pagination{DefaultLimit: 10, MaxLimit: 100})
+
+	tests := []struct {
+		name     string
+		slug     string
+		actor    *ent.User
+		wantCode int
+	}{
+		{name: "owner previews the current slug", slug: "current", actor: owner, wantCode: http.StatusOK},
+		{name: "owner is redirected from a former slug", slug: "former", actor: owner, wantCode: http.StatusMovedPermanently},
+		{name: "editor is redirected from a former slug", slug: "former", actor: editor, wantCode: http.StatusMovedPermanently},
+		{name: "other author may not preview", slug: "current", actor: other, wantCode: http.StatusForbidden},
+		{name: "other author is not redirected", slug: "former", actor: other, wantCode: http.StatusForbidden},
+		{name: "unknown slug", slug: "missing", actor: owner, wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/editor/posts/"+tt.slug, nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("slug")
+			c.SetParamValues(tt.slug)
+			c.Set("user", tt.actor)
+
+			err := h.PreviewBlogPost(c)
+			code := rec.Code
+			if err != nil {
+				var httpErr *utils.HTTPError
+				if !errors.As(err, &httpErr) {
+					t.Fatalf("PreviewBlogPost() error = %v", err)
+				}
+				code = httpErr.Code
+			}
+			if code != tt.wantCode {
+				t.Fatalf("PreviewBlogPost() status = %d, want %d", code, tt.wantCode)
+			}
+			if code == http.StatusMovedPermanently {
+				if loc := rec.Header().Get(echo.HeaderLocation); loc != "/api/v1/editor/posts/current" {
+					t.Errorf("Location = %q, want /api/v1/editor/posts/current", loc)
+				}
+			}
+		})
+	}
+}
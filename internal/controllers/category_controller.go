@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// CategoryHandler handles HTTP requests for categories.
+type CategoryHandler struct {
+	service *services.CategoryService
+}
+
+// NewCategoryHandler creates a new CategoryHandler.
+func NewCategoryHandler(service *services.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		service: service,
+	}
+}
+
+// CreateCategory handles the creation of a category.
+// POST /categories
+func (h *CategoryHandler) CreateCategory(c echo.Context) error {
+	var input services.CategoryInput
+	if err := c.Bind(&input); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid request body", err)
+	}
+
+	category, err := h.service.CreateCategory(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			return utils.NewHTTPError(http.StatusBadRequest, "Invalid category", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to create category", err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"message": "Category created successfully",
+		"data":    category,
+	})
+}
+
+// GetCategories handles listing all categories with their published post counts.
+// GET /categories
+func (h *CategoryHandler) GetCategories(c echo.Context) error {
+	categories, err := h.service.GetCategories(c.Request().Context())
+	if err != nil {
+		log.Printf("Handler error getting categories: %v", err)
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve categories", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Categories retrieved successfully",
+		"data":    categories,
+	})
+}
+
+// GetCategoryBySlug handles retrieving a category with its parent and children.
+// GET /categories/:slug
+func (h *CategoryHandler) GetCategoryBySlug(c echo.Context) error {
+	category, err := h.service.GetCategoryBySlug(c.Request().Context(), c.Param("slug"))
+	if err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve category", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Category retrieved successfully",
+		"data":    category,
+	})
+}
+
+// UpdateCategory handles a partial update of a category.
+// PATCH /categories/:slug
+func (h *CategoryHandler) UpdateCategory(c echo.Context) error {
+	var input services.CategoryInput
+	if err := c.Bind(&input); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid request body", err)
+	}
+
+	category, err := h.service.UpdateCategory(c.Request().Context(), c.Param("slug"), input)
+	if err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			return utils.NewHTTPError(http.StatusBadRequest, "Invalid category", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to update category", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Category updated successfully",
+		"data":    category,
+	})
+}
+
+// DeleteCategory handles deleting a category.
+// DELETE /categories/:slug
+func (h *CategoryHandler) DeleteCategory(c echo.Context) error {
+	if err := h.service.DeleteCategory(c.Request().Context(), c.Param("slug")); err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to delete category", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Category deleted successfully",
+	})
+}
@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// ImageHandler serves uploaded images from the configured storage.
+type ImageHandler struct {
+	service *services.ImageService
+}
+
+// NewImageHandler creates a new ImageHandler.
+func NewImageHandler(service *services.ImageService) *ImageHandler {
+	return &ImageHandler{
+		service: service,
+	}
+}
+
+// imageCacheControl lets clients and proxies reuse images for an hour, then
+// revalidate them with the ETag and Last-Modified headers.
+const imageCacheControl = "public, max-age=3600"
+
+// transformParams are the query parameters of image transforms, in the order errors are reported.
+var transformParams = []string{"w", "h", "fit", "format", "q"}
+
+// ServeImage streams a stored image, supporting range and conditional requests.
+// With any transform parameter, the image is resized and re-encoded on the fly.
+// GET /images/*?w=<int>&h=<int>&fit=<contain|cover|fill>&format=<jpeg|png>&q=<int>
+func (h *ImageHandler) ServeImage(c echo.Context) error {
+	key := c.Param("*")
+
+	opts, transform, err := h.parseTransform(c)
+	if err != nil {
+		return err
+	}
+	if transform {
+		return h.serveTransformed(c, key, opts)
+	}
+
+	obj, info, err := h.service.OpenImage(c.Request().Context(), key)
+	if err != nil {
+		return imageError(err)
+	}
+	defer obj.Close()
+
+	etag := fmt.Sprintf(`"%x-%x"`, info.Size, info.ModTime.UnixNano())
+	serveImageContent(c, key, obj, info.ContentType, info.ModTime, etag)
+	return nil
+}
+
+// serveTransformed streams the transformed image.
+func (h *ImageHandler) serveTransformed(c echo.Context, key string, opts services.TransformOptions) error {
+	img, err := h.service.TransformImage(c.Request().Context(), key, opts)
+	if err != nil {
+		return imageError(err)
+	}
+	defer img.Close()
+
+	serveImageContent(c, key, img, img.Info.ContentType, img.Info.ModTime, img.ETag)
+	return nil
+}
+
+// parseTransform reads the transform query parameters and checks them against the
+// configured whitelist. The boolean reports whether any transform was requested.
+func (h *ImageHandler) parseTransform(c echo.Context) (services.TransformOptions, bool, error) {
+	var (
+		opts      services.TransformOptions
+		errs      queryErrors
+		transform bool
+	)
+
+	intParam := func(name string) int {
+		v := c.QueryParam(name)
+		if v == "" {
+			return 0
+		}
+		transform = true
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			errs.add(name, "must be a positive integer")
+			return 0
+		}
+		return n
+	}
+	opts.Width = intParam("w")
+	opts.Height = intParam("h")
+	opts.Quality = intParam("q")
+	opts.Fit = c.QueryParam("fit")
+	opts.Format = c.QueryParam("format")
+	transform = transform || opts.Fit != "" || opts.Format != ""
+
+	if !transform {
+		return opts, false, nil
+	}
+
+	rejected := h.service.ValidateTransform(opts)
+	for _, name := range transformParams {
+		if msg, ok := rejected[name]; ok {
+			errs.add(name, "%s", msg)
+		}
+	}
+	return opts, true, errs.err()
+}
+
+// serveImageContent writes an image with caching headers. http.ServeContent
+// answers conditional requests against the ETag and modification time.
+func serveImageContent(c echo.Context, name string, content io.ReadSeeker, contentType string, modTime time.Time, etag string) {
+	header := c.Response().Header()
+	if contentType != "" {
+		header.Set(echo.HeaderContentType, contentType)
+	}
+	header.Set("Cache-Control", imageCacheControl)
+	header.Set("ETag", etag)
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	http.ServeContent(c.Response(), c.Request(), name, modTime, content)
+}
+
+// imageError maps an error from opening or transforming an image to an HTTP error.
+func imageError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		return utils.NewHTTPError(http.StatusNotFound, "Image not found", nil)
+	case errors.Is(err, services.ErrUnsupportedMediaType):
+		return utils.NewHTTPError(http.StatusUnsupportedMediaType, "Image cannot be transformed", err)
+	}
+	log.Printf("Handler error serving image: %v", err)
+	return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve image", err)
+}
+
+// uploadError maps an error from ImageService.UploadImage to an HTTP error.
+func uploadError(err error, message string) error {
+	switch {
+	case errors.Is(err, services.ErrUnsupportedMediaType):
+		return utils.NewHTTPError(http.StatusUnsupportedMediaType, message, err)
+	case errors.Is(err, services.ErrTooLarge):
+		return utils.NewHTTPError(http.StatusRequestEntityTooLarge, message, err)
+	case errors.Is(err, services.ErrInvalidInput):
+		return utils.NewHTTPError(http.StatusBadRequest, message, err)
+	}
+	log.Printf("Handler error uploading image: %v", err)
+	return utils.NewHTTPError(http.StatusInternalServerError, message, err)
+}
@@ -0,0 +1,164 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// dateLayout is the date-only format accepted by date-range filters besides RFC3339.
+const dateLayout = "2006-01-02"
+
+// invalidQueryMessage is the message of every listing query validation error.
+const invalidQueryMessage = "Invalid query parameters"
+
+// maxPage is the highest page a listing may ask for. It keeps the row offset
+// (page-1)*limit from overflowing, and the database from skipping millions of
+// rows; deeper blog post listings use the after and before cursors instead.
+const maxPage = 10000
+
+// Pagination holds the page size limits applied to paginated listings.
+type Pagination struct {
+	// DefaultLimit is used when a request has no limit parameter.
+	DefaultLimit int
+	// MaxLimit is the largest limit a request may ask for.
+	MaxLimit int
+}
+
+// queryErrors collects the query parameters rejected while parsing a request.
+type queryErrors []utils.FieldError
+
+// add records that param was rejected with a formatted message.
+func (errs *queryErrors) add(param, format string, args ...interface{}) {
+	*errs = append(*errs, utils.FieldError{Field: param, Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns a validation HTTPError listing the rejected parameters, or nil if there are none.
+func (errs queryErrors) err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return utils.NewValidationError(invalidQueryMessage, errs)
+}
+
+// parse reads the page and limit query parameters. Missing values fall back to
+// page 1 and the default limit; malformed or out-of-range values are recorded in errs.
+func (p Pagination) parse(c echo.Context, errs *queryErrors) (int, int) {
+	page, limit := 1, p.DefaultLimit
+
+	if v := c.QueryParam("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPage {
+			errs.add("page", "must be an integer between 1 and %d", maxPage)
+		} else {
+			page = n
+		}
+	}
+
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > p.MaxLimit {
+			errs.add("limit", "must be an integer between 1 and %d", p.MaxLimit)
+		} else {
+			limit = n
+		}
+	}
+
+	return page, limit
+}
+
+// parsePage reads only the page and limit query parameters.
+func (p Pagination) parsePage(c echo.Context) (int, int, error) {
+	var errs queryErrors
+	page, limit := p.parse(c, &errs)
+	return page, limit, errs.err()
+}
+
+// parseListParams reads the pagination, search, filter, sort and fieldset
+// query parameters shared by the blog post listings.
+func (p Pagination) parseListParams(c echo.Context) (services.ListBlogPostsParams, error) {
+	var errs queryErrors
+	page, limit := p.parse(c, &errs)
+
+	params := services.ListBlogPostsParams{
+		Page:         page,
+		Limit:        limit,
+		After:        c.QueryParam("after"),
+		Before:       c.QueryParam("before"),
+		Search:       c.QueryParam("search"),
+		CategorySlug: c.QueryParam("category"),
+		TagSlugs:     getListValue(c.QueryParams()["tag"]),
+		Sort:         getListValue(c.QueryParams()["sort"]),
+		Fields:       getListValue(c.QueryParams()["fields"]),
+	}
+
+	if params.After != "" && params.Before != "" {
+		errs.add("before", "cannot be combined with after")
+	}
+
+	params.PublishedFrom = parseDateParam(c, "published_from", false, &errs)
+	params.PublishedTo = parseDateParam(c, "published_to", true, &errs)
+	params.CreatedFrom = parseDateParam(c, "created_from", false, &errs)
+	params.CreatedTo = parseDateParam(c, "created_to", true, &errs)
+	if params.PublishedFrom != nil && params.PublishedTo != nil && params.PublishedTo.Before(*params.PublishedFrom) {
+		errs.add("published_to", "must not be before published_from")
+	}
+	if params.CreatedFrom != nil && params.CreatedTo != nil && params.CreatedTo.Before(*params.CreatedFrom) {
+		errs.add("created_to", "must not be before created_from")
+	}
+
+	if v := c.QueryParam("has_image"); v != "" {
+		hasImage, err := strconv.ParseBool(v)
+		if err != nil {
+			errs.add("has_image", "must be true or false")
+		} else {
+			params.HasImage = &hasImage
+		}
+	}
+
+	return params, errs.err()
+}
+
+// parseDateParam parses an RFC3339 timestamp or YYYY-MM-DD date query parameter.
+// A bare date used as an upper bound covers that whole day.
+func parseDateParam(c echo.Context, name string, endOfDay bool, errs *queryErrors) *time.Time {
+	v := c.QueryParam(name)
+	if v == "" {
+		return nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return &t
+	}
+
+	t, err := time.Parse(dateLayout, v)
+	if err != nil {
+		errs.add(name, "must be an RFC3339 timestamp or a YYYY-MM-DD date")
+		return nil
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return &t
+}
+
+// listingError maps an error from a listing service call to an HTTP error.
+// Rejected parameters are reported with field-level details.
+func listingError(err error, message string) error {
+	var paramErr *services.ParamError
+	if errors.As(err, &paramErr) {
+		return utils.NewValidationError(invalidQueryMessage, []utils.FieldError{
+			{Field: paramErr.Param, Message: paramErr.Message},
+		})
+	}
+	if errors.Is(err, services.ErrInvalidInput) {
+		return utils.NewHTTPError(http.StatusBadRequest, invalidQueryMessage, err)
+	}
+	return utils.NewHTTPError(http.StatusInternalServerError, message, err)
+}
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+func TestPaginationParsePage(t *testing.T) {
+	p := Pagination{DefaultLimit: 10, MaxLimit: 100}
+
+	tests := []struct {
+		name      string
+		query     url.Values
+		wantPage  int
+		wantLimit int
+		wantField string
+	}{
+		{name: "defaults", wantPage: 1, wantLimit: 10},
+		{name: "explicit", query: url.Values{"page": {"3"}, "limit": {"25"}}, wantPage: 3, wantLimit: 25},
+		{name: "max page", query: url.Values{"page": {strconv.Itoa(maxPage)}, "limit": {"100"}}, wantPage: maxPage, wantLimit: 100},
+		{name: "page zero", query: url.Values{"page": {"0"}}, wantField: "page"},
+		{name: "negative page", query: url.Values{"page": {"-1"}}, wantField: "page"},
+		{name: "page past the max", query: url.Values{"page": {strconv.Itoa(maxPage + 1)}}, wantField: "page"},
+		{name: "page overflowing the offset", query: url.Values{"page": {"9223372036854775807"}, "limit": {"100"}}, wantField: "page"},
+		{name: "page beyond int", query: url.Values{"page": {"99999999999999999999"}}, wantField: "page"},
+		{name: "malformed page", query: url.Values{"page": {"two"}}, wantField: "page"},
+		{name: "limit past the max", query: url.Values{"limit": {"101"}}, wantField: "limit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+tt.query.Encode(), nil)
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			page, limit, err := p.parsePage(c)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("parsePage() error = %v", err)
+				}
+				if page != tt.wantPage || limit != tt.wantLimit {
+					t.Errorf("parsePage() = %d, %d, want %d, %d", page, limit, tt.wantPage, tt.wantLimit)
+				}
+				return
+			}
+
+			var httpErr *utils.HTTPError
+			if !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+				t.Fatalf("parsePage() error = %v, want a 400 validation error", err)
+			}
+			if len(httpErr.Errors) != 1 || httpErr.Errors[0].Field != tt.wantField {
+				t.Errorf("parsePage() field errors = %+v, want one for %s", httpErr.Errors, tt.wantField)
+			}
+		})
+	}
+}
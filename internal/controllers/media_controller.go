@@ -0,0 +1,227 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+	"github.com/AdongoJr2/technoprise-backend/internal/middleware"
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// MediaHandler handles HTTP requests for the media library.
+type MediaHandler struct {
+	service      *services.MediaService
+	imageService *services.ImageService
+	pagination   Pagination
+}
+
+// NewMediaHandler creates a new MediaHandler.
+func NewMediaHandler(service *services.MediaService, imageService *services.ImageService, pagination Pagination) *MediaHandler {
+	return &MediaHandler{
+		service:      service,
+		imageService: imageService,
+		pagination:   pagination,
+	}
+}
+
+// UploadMedia handles uploading a file to the media library.
+// POST /media
+func (h *MediaHandler) UploadMedia(c echo.Context) error {
+	if err := c.Request().ParseMultipartForm(10 << 20); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Failed to parse form data", err)
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid form data", err)
+	}
+
+	files := form.File["file"]
+	if len(files) == 0 {
+		return utils.NewHTTPError(http.StatusBadRequest, "File is required", nil)
+	}
+
+	meta := uploadMeta(c)
+	meta.AltText = getFirstValue(form.Value["alt_text"])
+	meta.Caption = getFirstValue(form.Value["caption"])
+
+	img, err := h.imageService.UploadResponsiveImage(c, files[0], meta)
+	if err != nil {
+		return uploadError(err, "Failed to upload media")
+	}
+
+	m, err := h.service.GetMediaByID(c.Request().Context(), img.MediaID)
+	if err != nil {
+		log.Printf("Handler error getting uploaded media: %v", err)
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve media", err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"message": "Media uploaded successfully",
+		"data":    h.view(c, m),
+	})
+}
+
+// GetMedia handles retrieving a paginated list of media, optionally filtered by a search term.
+// GET /media?page=<int>&limit=<int>&search=<string>
+func (h *MediaHandler) GetMedia(c echo.Context) error {
+	page, limit, err := h.pagination.parsePage(c)
+	if err != nil {
+		return err
+	}
+
+	paginatedMedia, err := h.service.GetMedia(c.Request().Context(), services.ListMediaParams{
+		Page:   page,
+		Limit:  limit,
+		Search: c.QueryParam("search"),
+	})
+	if err != nil {
+		log.Printf("Handler error getting media: %v", err)
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve media", err)
+	}
+
+	items := make([]*services.MediaView, len(paginatedMedia.Data))
+	for i, m := range paginatedMedia.Data {
+		items[i] = h.view(c, m)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":    "Media retrieved successfully",
+		"data":       items,
+		"pagination": paginatedMedia.Pagination,
+	})
+}
+
+// GetMediaByID handles retrieving a single media library entry and the posts using it.
+// GET /media/:id
+func (h *MediaHandler) GetMediaByID(c echo.Context) error {
+	id, err := mediaID(c)
+	if err != nil {
+		return err
+	}
+
+	m, err := h.service.GetMediaByID(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("Handler error getting media: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve media", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Media retrieved successfully",
+		"data":    h.view(c, m),
+	})
+}
+
+// UpdateMedia handles changing the alt text and caption of a media library entry.
+// PATCH /media/:id
+func (h *MediaHandler) UpdateMedia(c echo.Context) error {
+	id, err := mediaID(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.authorizeMedia(c, id); err != nil {
+		return err
+	}
+
+	var input services.MediaInput
+	if err := c.Bind(&input); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid request body", err)
+	}
+
+	m, err := h.service.UpdateMedia(c.Request().Context(), id, input)
+	if err != nil {
+		log.Printf("Handler error updating media: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			return utils.NewHTTPError(http.StatusBadRequest, "Invalid media", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to update media", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Media updated successfully",
+		"data":    h.view(c, m),
+	})
+}
+
+// DeleteMedia handles deleting a media library entry and its files.
+// DELETE /media/:id
+func (h *MediaHandler) DeleteMedia(c echo.Context) error {
+	id, err := mediaID(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.authorizeMedia(c, id); err != nil {
+		return err
+	}
+
+	// Only editors may take an image away from posts and authors still using it
+	u := middleware.CurrentUser(c)
+	detach := u != nil && (u.Role == user.RoleAdmin || u.Role == user.RoleEditor)
+	if err := h.service.DeleteMedia(c.Request().Context(), id, detach); err != nil {
+		log.Printf("Handler error deleting media: %v", err)
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		if errors.Is(err, services.ErrConflict) {
+			return utils.NewHTTPError(http.StatusConflict, "Media is still in use", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to delete media", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Media deleted successfully",
+	})
+}
+
+// authorizeMedia checks that the authenticated user may manage the media library entry id.
+func (h *MediaHandler) authorizeMedia(c echo.Context, id int) error {
+	err := h.service.AuthorizeMediaAccess(c.Request().Context(), id, middleware.CurrentUser(c))
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, services.ErrNotFound) {
+		return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+	}
+	if errors.Is(err, services.ErrForbidden) {
+		return utils.NewHTTPError(http.StatusForbidden, "You do not have permission to manage this media", nil)
+	}
+	log.Printf("Handler error authorizing media access: %v", err)
+	return utils.NewHTTPError(http.StatusInternalServerError, "Failed to authorize request", err)
+}
+
+// view wraps m with its public URL for a response.
+func (h *MediaHandler) view(c echo.Context, m *ent.Media) *services.MediaView {
+	return services.NewMediaView(m, h.imageService.URL(c.Request().Context(), m.Key))
+}
+
+// mediaID parses the :id path parameter.
+func mediaID(c echo.Context) (int, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id < 1 {
+		return 0, utils.NewHTTPError(http.StatusBadRequest, "Media id must be a positive integer", err)
+	}
+	return id, nil
+}
+
+// uploadMeta describes an upload by the authenticated user for the media library.
+func uploadMeta(c echo.Context) services.MediaInput {
+	var meta services.MediaInput
+	if u := middleware.CurrentUser(c); u != nil {
+		meta.UploaderID = &u.ID
+	}
+	return meta
+}
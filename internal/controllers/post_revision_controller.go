@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/middleware"
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// GetRevisions handles listing the revisions of a blog post, newest first.
+// GET /posts/:slug/revisions
+func (h *BlogPostHandler) GetRevisions(c echo.Context) error {
+	slug := c.Param("slug")
+	if err := h.authorizePost(c, slug); err != nil {
+		return err
+	}
+
+	revisions, err := h.service.ListRevisions(c.Request().Context(), slug)
+	if err != nil {
+		log.Printf("Handler error listing revisions: %v", err)
+		return revisionError(err, "Failed to retrieve revisions")
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Revisions retrieved successfully",
+		"data":    revisions,
+	})
+}
+
+// GetRevision handles retrieving one revision of a blog post, with its content.
+// GET /posts/:slug/revisions/:number
+func (h *BlogPostHandler) GetRevision(c echo.Context) error {
+	slug := c.Param("slug")
+	number, err := revisionNumber(c)
+	if err != nil {
+		return err
+	}
+	if err := h.authorizePost(c, slug); err != nil {
+		return err
+	}
+
+	revision, err := h.service.GetRevision(c.Request().Context(), slug, number)
+	if err != nil {
+		log.Printf("Handler error getting revision: %v", err)
+		return revisionError(err, "Failed to retrieve revision")
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Revision retrieved successfully",
+		"data":    revision,
+	})
+}
+
+// DiffRevisions handles comparing two revisions of a blog post.
+// GET /posts/:slug/revisions/diff?from=<number>&to=<number>
+func (h *BlogPostHandler) DiffRevisions(c echo.Context) error {
+	slug := c.Param("slug")
+
+	var errs queryErrors
+	from := parseRevisionParam(c, "from", &errs)
+	to := parseRevisionParam(c, "to", &errs)
+	if err := errs.err(); err != nil {
+		return err
+	}
+	if err := h.authorizePost(c, slug); err != nil {
+		return err
+	}
+
+	diff, err := h.service.DiffRevisions(c.Request().Context(), slug, from, to)
+	if err != nil {
+		log.Printf("Handler error diffing revisions: %v", err)
+		return revisionError(err, "Failed to compare revisions")
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Revisions compared successfully",
+		"data":    diff,
+	})
+}
+
+// RestoreRevision handles restoring a blog post to a previous revision, which
+// is recorded as a new revision.
+// POST /posts/:slug/revisions/:number/restore
+func (h *BlogPostHandler) RestoreRevision(c echo.Context) error {
+	slug := c.Param("slug")
+	number, err := revisionNumber(c)
+	if err != nil {
+		return err
+	}
+	if err := h.authorizePost(c, slug); err != nil {
+		return err
+	}
+
+	var editorID *int
+	if u := middleware.CurrentUser(c); u != nil {
+		editorID = &u.ID
+	}
+
+	post, err := h.service.RestoreRevision(c.Request().Context(), slug, number, editorID)
+	if err != nil {
+		log.Printf("Handler error restoring revision: %v", err)
+		if errors.Is(err, services.ErrInvalidInput) {
+			return invalidPostError(err)
+		}
+		return revisionError(err, "Failed to restore revision")
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Revision restored successfully",
+		"data":    services.NewBlogPostView(post),
+	})
+}
+
+// revisionNumber parses the :number path parameter.
+func revisionNumber(c echo.Context) (int, error) {
+	number, err := strconv.Atoi(c.Param("number"))
+	if err != nil || number < 1 {
+		return 0, utils.NewHTTPError(http.StatusBadRequest, "Revision number must be a positive integer", err)
+	}
+	return number, nil
+}
+
+// parseRevisionParam reads a required revision number query parameter,
+// recording it in errs when missing or malformed.
+func parseRevisionParam(c echo.Context, param string, errs *queryErrors) int {
+	v := c.QueryParam(param)
+	if v == "" {
+		errs.add(param, "is required")
+		return 0
+	}
+	number, err := strconv.Atoi(v)
+	if err != nil || number < 1 {
+		errs.add(param, "must be a positive integer")
+		return 0
+	}
+	return number
+}
+
+// revisionError maps a revision service error to an HTTP error.
+func revisionError(err error, message string) error {
+	if errors.Is(err, services.ErrNotFound) {
+		return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+	}
+	return utils.NewHTTPError(http.StatusInternalServerError, message, err)
+}
@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// TagHandler handles HTTP requests for tags.
+type TagHandler struct {
+	service *services.TagService
+}
+
+// NewTagHandler creates a new TagHandler.
+func NewTagHandler(service *services.TagService) *TagHandler {
+	return &TagHandler{
+		service: service,
+	}
+}
+
+// CreateTag handles the creation of a tag.
+// POST /tags
+func (h *TagHandler) CreateTag(c echo.Context) error {
+	var input services.TagInput
+	if err := c.Bind(&input); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid request body", err)
+	}
+
+	tag, err := h.service.CreateTag(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			return utils.NewHTTPError(http.StatusBadRequest, "Invalid tag", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to create tag", err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"message": "Tag created successfully",
+		"data":    tag,
+	})
+}
+
+// GetTags handles listing all tags with their published post counts, for tag clouds.
+// GET /tags
+func (h *TagHandler) GetTags(c echo.Context) error {
+	tags, err := h.service.GetTags(c.Request().Context())
+	if err != nil {
+		log.Printf("Handler error getting tags: %v", err)
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve tags", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Tags retrieved successfully",
+		"data":    tags,
+	})
+}
+
+// GetTagBySlug handles retrieving a single tag.
+// GET /tags/:slug
+func (h *TagHandler) GetTagBySlug(c echo.Context) error {
+	tag, err := h.service.GetTagBySlug(c.Request().Context(), c.Param("slug"))
+	if err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve tag", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Tag retrieved successfully",
+		"data":    tag,
+	})
+}
+
+// UpdateTag handles a partial update of a tag.
+// PATCH /tags/:slug
+func (h *TagHandler) UpdateTag(c echo.Context) error {
+	var input services.TagInput
+	if err := c.Bind(&input); err != nil {
+		return utils.NewHTTPError(http.StatusBadRequest, "Invalid request body", err)
+	}
+
+	tag, err := h.service.UpdateTag(c.Request().Context(), c.Param("slug"), input)
+	if err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			return utils.NewHTTPError(http.StatusBadRequest, "Invalid tag", err)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to update tag", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Tag updated successfully",
+		"data":    tag,
+	})
+}
+
+// DeleteTag handles deleting a tag.
+// DELETE /tags/:slug
+func (h *TagHandler) DeleteTag(c echo.Context) error {
+	if err := h.service.DeleteTag(c.Request().Context(), c.Param("slug")); err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return utils.NewHTTPError(http.StatusNotFound, err.Error(), nil)
+		}
+		return utils.NewHTTPError(http.StatusInternalServerError, "Failed to delete tag", err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Tag deleted successfully",
+	})
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// userContextKey is the echo.Context key holding the authenticated *ent.User.
+const userContextKey = "user"
+
+// Authenticate requires a valid "Authorization: Bearer <token>" header and
+// stores the session's user on the context.
+func Authenticate(authService *services.AuthService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token := BearerToken(c)
+			if token == "" {
+				return utils.NewHTTPError(http.StatusUnauthorized, "Authentication required", nil)
+			}
+
+			u, err := authService.Authenticate(c.Request().Context(), token)
+			if err != nil {
+				if errors.Is(err, services.ErrUnauthorized) {
+					return utils.NewHTTPError(http.StatusUnauthorized, "Invalid or expired session", nil)
+				}
+				return utils.NewHTTPError(http.StatusInternalServerError, "Failed to authenticate", err)
+			}
+
+			c.Set(userContextKey, u)
+			return next(c)
+		}
+	}
+}
+
+// RequireRole allows the request through only if the authenticated user has one of roles.
+// It must run after Authenticate.
+func RequireRole(roles ...user.Role) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			u := CurrentUser(c)
+			if u == nil {
+				return utils.NewHTTPError(http.StatusUnauthorized, "Authentication required", nil)
+			}
+			for _, role := range roles {
+				if u.Role == role {
+					return next(c)
+				}
+			}
+			return utils.NewHTTPError(http.StatusForbidden, "You do not have permission to perform this action", nil)
+		}
+	}
+}
+
+// CurrentUser returns the authenticated user, or nil if the request is anonymous.
+func CurrentUser(c echo.Context) *ent.User {
+	u, _ := c.Get(userContextKey).(*ent.User)
+	return u
+}
+
+// BearerToken extracts the token from the Authorization header.
+func BearerToken(c echo.Context) string {
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// PublicBaseURL stores the origin the client reached the API at on the request
+// context, for building absolute URLs. Forwarded headers are only honored from
+// the trusted proxies.
+func PublicBaseURL(trusted []*net.IPNet) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			baseURL := utils.PublicBaseURL(req, trusted)
+			c.SetRequest(req.WithContext(utils.WithPublicBaseURL(req.Context(), baseURL)))
+			return next(c)
+		}
+	}
+}
+
+// ClientIPExtractor returns how echo.Context.RealIP finds the client's address.
+// Without trusted proxies it is the connection's peer; with them it is the
+// rightmost X-Forwarded-For address that is not one of the trusted proxies.
+func ClientIPExtractor(trusted []*net.IPNet) echo.IPExtractor {
+	if len(trusted) == 0 {
+		return echo.ExtractIPDirect()
+	}
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, network := range trusted {
+		options = append(options, echo.TrustIPRange(network))
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
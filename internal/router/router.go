@@ -1,23 +1,95 @@
 package router
 
 import (
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
 	"github.com/AdongoJr2/technoprise-backend/internal/controllers"
+	"github.com/AdongoJr2/technoprise-backend/internal/middleware"
+	"github.com/AdongoJr2/technoprise-backend/internal/services"
 	"github.com/AdongoJr2/technoprise-backend/internal/utils"
 	"github.com/labstack/echo/v4"
 )
 
 // RegisterRoutes sets up all API routes for the application.
-func RegisterRoutes(e *echo.Echo, blogPostController *controllers.BlogPostHandler) {
+func RegisterRoutes(
+	e *echo.Echo,
+	authService *services.AuthService,
+	authController *controllers.AuthHandler,
+	blogPostController *controllers.BlogPostHandler,
+	authorController *controllers.AuthorHandler,
+	categoryController *controllers.CategoryHandler,
+	tagController *controllers.TagHandler,
+	mediaController *controllers.MediaHandler,
+) {
 	// Set custom HTTP error handler
 	e.HTTPErrorHandler = utils.CustomHTTPErrorHandler
 
 	// Group API routes
 	api := e.Group("/api/v1")
 
+	// Role guards for write routes
+	authenticated := middleware.Authenticate(authService)
+	writers := middleware.RequireRole(user.RoleAdmin, user.RoleEditor, user.RoleAuthor)
+	editors := middleware.RequireRole(user.RoleAdmin, user.RoleEditor)
+	admins := middleware.RequireRole(user.RoleAdmin)
+
+	// Auth Routes
+	api.POST("/auth/register", authController.Register)
+	api.POST("/auth/login", authController.Login)
+	api.POST("/auth/logout", authController.Logout, authenticated)
+	api.GET("/auth/me", authController.Me, authenticated)
+
+	// User Routes
+	api.POST("/users", authController.CreateUser, authenticated, admins)
+	api.GET("/users", authController.GetUsers, authenticated, admins)
+
 	// Blog Post Routes
-	api.POST("/posts", blogPostController.CreateBlogPost)
+	api.POST("/posts", blogPostController.CreateBlogPost, authenticated, writers)
 	api.GET("/posts", blogPostController.GetBlogPosts)
 	api.GET("/posts/:slug", blogPostController.GetBlogPostBySlug)
+	api.PATCH("/posts/:slug", blogPostController.UpdateBlogPost, authenticated, writers)
+	api.DELETE("/posts/:slug", blogPostController.DeleteBlogPost, authenticated, writers)
+	api.POST("/posts/:slug/restore", blogPostController.RestoreBlogPost, authenticated, writers)
+	api.PUT("/posts/:slug/status", blogPostController.TransitionBlogPost, authenticated, editors)
+
+	// Revision History Routes
+	api.GET("/posts/:slug/revisions", blogPostController.GetRevisions, authenticated, writers)
+	api.GET("/posts/:slug/revisions/diff", blogPostController.DiffRevisions, authenticated, writers)
+	api.GET("/posts/:slug/revisions/:number", blogPostController.GetRevision, authenticated, writers)
+	api.POST("/posts/:slug/revisions/:number/restore", blogPostController.RestoreRevision, authenticated, writers)
+
+	// Author Routes
+	api.GET("/authors", authorController.GetAuthors)
+	api.GET("/authors/:slug", authorController.GetAuthorBySlug)
+	api.GET("/authors/:slug/posts", authorController.GetAuthorPosts)
+	api.POST("/authors", authorController.CreateAuthor, authenticated, editors)
+	api.PATCH("/authors/:slug", authorController.UpdateAuthor, authenticated, editors)
+
+	// Category Routes
+	api.GET("/categories", categoryController.GetCategories)
+	api.GET("/categories/:slug", categoryController.GetCategoryBySlug)
+	api.POST("/categories", categoryController.CreateCategory, authenticated, editors)
+	api.PATCH("/categories/:slug", categoryController.UpdateCategory, authenticated, editors)
+	api.DELETE("/categories/:slug", categoryController.DeleteCategory, authenticated, editors)
+
+	// Tag Routes
+	api.GET("/tags", tagController.GetTags)
+	api.GET("/tags/:slug", tagController.GetTagBySlug)
+	api.POST("/tags", tagController.CreateTag, authenticated, editors)
+	api.PATCH("/tags/:slug", tagController.UpdateTag, authenticated, editors)
+	api.DELETE("/tags/:slug", tagController.DeleteTag, authenticated, editors)
+
+	// Media Library Routes
+	api.POST("/media", mediaController.UploadMedia, authenticated, writers)
+	api.GET("/media", mediaController.GetMedia, authenticated, writers)
+	api.GET("/media/:id", mediaController.GetMediaByID, authenticated, writers)
+	api.PATCH("/media/:id", mediaController.UpdateMedia, authenticated, writers)
+	api.DELETE("/media/:id", mediaController.DeleteMedia, authenticated, writers)
+
+	// Editor routes: drafts, scheduled and archived posts, and the trash
+	editor := api.Group("/editor", authenticated, writers)
+	editor.GET("/posts", blogPostController.GetEditorBlogPosts)
+	editor.GET("/posts/:slug", blogPostController.PreviewBlogPost)
+	editor.GET("/trash", blogPostController.GetTrash)
 
 	// Health check route
 	e.GET("/health", func(c echo.Context) error {
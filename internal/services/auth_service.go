@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/session"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrUnauthorized is returned when credentials or a session token are invalid.
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrForbidden is wrapped by service errors when the acting user lacks permission.
+var ErrForbidden = errors.New("forbidden")
+
+// minPasswordLength is the minimum accepted password length.
+const minPasswordLength = 8
+
+// AuthService provides user management, login and session validation.
+type AuthService struct {
+	client     *ent.Client
+	sessionTTL time.Duration
+}
+
+// NewAuthService creates a new AuthService.
+func NewAuthService(client *ent.Client, sessionTTL time.Duration) *AuthService {
+	return &AuthService{
+		client:     client,
+		sessionTTL: sessionTTL,
+	}
+}
+
+// CreateUserInput defines the input structure for creating a user.
+type CreateUserInput struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+	Role     string `json:"role,omitempty"`
+}
+
+// LoginResult holds the issued session token and the authenticated user.
+type LoginResult struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	User      *ent.User `json:"user"`
+}
+
+// CreateUser creates a new user with a hashed password. An empty role defaults to reader.
+func (s *AuthService) CreateUser(ctx context.Context, input CreateUserInput) (*ent.User, error) {
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+	if email == "" || !strings.Contains(email, "@") {
+		return nil, fmt.Errorf("%w: a valid email is required", ErrInvalidInput)
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidInput)
+	}
+	if len(input.Password) < minPasswordLength {
+		return nil, fmt.Errorf("%w: password must be at least %d characters", ErrInvalidInput, minPasswordLength)
+	}
+
+	role := user.RoleReader
+	if input.Role != "" {
+		role = user.Role(input.Role)
+		if err := user.RoleValidator(role); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	u, err := s.client.User.
+		Create().
+		SetEmail(email).
+		SetName(strings.TrimSpace(input.Name)).
+		SetPasswordHash(string(hash)).
+		SetRole(role).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: a user with email '%s' already exists", ErrInvalidInput, email)
+		}
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	return u, nil
+}
+
+// GetUsers lists all users.
+func (s *AuthService) GetUsers(ctx context.Context) ([]*ent.User, error) {
+	users, err := s.client.User.Query().Order(ent.Asc(user.FieldID)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	return users, nil
+}
+
+// EnsureAdmin creates an admin user from the given credentials when no users exist yet.
+func (s *AuthService) EnsureAdmin(ctx context.Context, email, password string) error {
+	if email == "" || password == "" {
+		return nil
+	}
+
+	exists, err := s.client.User.Query().Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check for existing users: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	if _, err := s.CreateUser(ctx, CreateUserInput{
+		Email:    email,
+		Name:     "Administrator",
+		Password: password,
+		Role:     string(user.RoleAdmin),
+	}); err != nil {
+		return err
+	}
+	log.Printf("Created initial admin user '%s'", email)
+	return nil
+}
+
+// Login verifies the credentials and issues a new session token.
+func (s *AuthService) Login(ctx context.Context, email, password string) (*LoginResult, error) {
+	u, err := s.client.User.Query().
+		Where(user.EmailEQ(strings.ToLower(strings.TrimSpace(email)))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUnauthorized
+		}
+		return nil, fmt.Errorf("failed to look up user: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	// Drop this user's expired sessions while we're here
+	if _, err := s.client.Session.Delete().
+		Where(
+			session.HasUserWith(user.IDEQ(u.ID)),
+			session.ExpiresAtLTE(time.Now()),
+		).
+		Exec(ctx); err != nil {
+		log.Printf("Error purging expired sessions: %v", err)
+	}
+
+	token, err := newSessionToken()
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(s.sessionTTL)
+	_, err = s.client.Session.
+		Create().
+		SetTokenHash(hashToken(token)).
+		SetExpiresAt(expiresAt).
+		SetUser(u).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return &LoginResult{
+		Token:     token,
+		ExpiresAt: expiresAt,
+		User:      u,
+	}, nil
+}
+
+// Logout revokes the session identified by token.
+func (s *AuthService) Logout(ctx context.Context, token string) error {
+	_, err := s.client.Session.Delete().
+		Where(session.TokenHashEQ(hashToken(token))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+// Authenticate returns the user owning an unexpired session for token.
+func (s *AuthService) Authenticate(ctx context.Context, token string) (*ent.User, error) {
+	if token == "" {
+		return nil, ErrUnauthorized
+	}
+
+	u, err := s.client.Session.Query().
+		Where(
+			session.TokenHashEQ(hashToken(token)),
+			session.ExpiresAtGT(time.Now()),
+		).
+		QueryUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUnauthorized
+		}
+		return nil, fmt.Errorf("failed to validate session: %w", err)
+	}
+	return u, nil
+}
+
+// newSessionToken returns a random, URL-safe bearer token.
+func newSessionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate session token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken returns the hex SHA-256 of a session token as stored in the database.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+)
+
+// AuthorService provides business logic for author profiles.
+type AuthorService struct {
+	client       *ent.Client
+	imageService *ImageService
+}
+
+// NewAuthorService creates a new AuthorService.
+func NewAuthorService(client *ent.Client, imageService *ImageService) *AuthorService {
+	return &AuthorService{
+		client:       client,
+		imageService: imageService,
+	}
+}
+
+// CreateAuthorInput defines the input structure for creating an author profile.
+type CreateAuthorInput struct {
+	Name        string            `json:"name"`
+	Slug        *string           `json:"slug,omitempty"`
+	Bio         *string           `json:"bio,omitempty"`
+	Avatar      *string           `json:"avatar,omitempty"`
+	SocialLinks map[string]string `json:"social_links,omitempty"`
+	// UserID links the profile to a login, so that user's posts get this byline.
+	UserID *int `json:"user_id,omitempty"`
+}
+
+// UpdateAuthorInput defines the input structure for updating an author profile.
+// Nil fields are left untouched.
+type UpdateAuthorInput struct {
+	Name         *string           `json:"name,omitempty"`
+	Bio          *string           `json:"bio,omitempty"`
+	Avatar       *string           `json:"avatar,omitempty"`
+	RemoveAvatar bool              `json:"remove_avatar,omitempty"`
+	SocialLinks  map[string]string `json:"social_links,omitempty"`
+}
+
+// PaginatedAuthors holds authors and pagination metadata.
+type PaginatedAuthors struct {
+	Data       []*ent.Author  `json:"data"`
+	Pagination PaginationMeta `json:"pagination"`
+}
+
+// CreateAuthor creates a new author profile.
+func (s *AuthorService) CreateAuthor(ctx context.Context, input CreateAuthorInput) (*ent.Author, error) {
+	return retrySlugConflict(func() (*ent.Author, error) {
+		return s.createAuthor(ctx, input)
+	})
+}
+
+// createAuthor makes a single attempt at CreateAuthor.
+func (s *AuthorService) createAuthor(ctx context.Context, input CreateAuthorInput) (*ent.Author, error) {
+	var slug string
+	if input.Slug != nil && *input.Slug != "" {
+		slug = utils.GenerateSlug(*input.Slug)
+	} else {
+		slug = utils.GenerateSlug(input.Name)
+	}
+	if slug == "" {
+		return nil, fmt.Errorf("%w: slug cannot be empty", ErrInvalidInput)
+	}
+
+	taken, err := s.client.Author.Query().
+		Where(slugFamily[predicate.Author](slug)).
+		Select(author.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		log.Printf("Error checking for existing author slug: %v", err)
+		return nil, fmt.Errorf("failed to check for existing author slug: %w", err)
+	}
+	slug = nextFreeSlug(slug, taken)
+
+	authorCreate := s.client.Author.
+		Create().
+		SetName(input.Name).
+		SetSlug(slug).
+		SetNillableBio(input.Bio).
+		SetNillableAvatar(input.Avatar)
+
+	if input.SocialLinks != nil {
+		authorCreate = authorCreate.SetSocialLinks(input.SocialLinks)
+	}
+
+	if input.UserID != nil {
+		exists, err := s.client.User.Query().Where(user.IDEQ(*input.UserID)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check user: %w", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: user %d does not exist", ErrInvalidInput, *input.UserID)
+		}
+		// Checked here rather than read from a constraint error, which a slug
+		// taken concurrently raises too
+		hasProfile, err := s.client.Author.Query().Where(author.HasUserWith(user.IDEQ(*input.UserID))).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check user's author profile: %w", err)
+		}
+		if hasProfile {
+			return nil, fmt.Errorf("%w: user already has an author profile", ErrInvalidInput)
+		}
+		authorCreate = authorCreate.SetUserID(*input.UserID)
+	}
+
+	a, err := authorCreate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create author: %w", err)
+	}
+	s.imageService.resolveAuthors(ctx, a)
+	return a, nil
+}
+
+// UpdateAuthor applies a partial update to the author identified by slug.
+// A replaced or removed avatar is deleted from storage once the update succeeds.
+func (s *AuthorService) UpdateAuthor(ctx context.Context, slug string, input UpdateAuthorInput) (*ent.Author, error) {
+	a, err := s.findBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	authorUpdate := a.Update().
+		SetNillableName(input.Name).
+		SetNillableBio(input.Bio)
+
+	if input.SocialLinks != nil {
+		authorUpdate = authorUpdate.SetSocialLinks(input.SocialLinks)
+	}
+
+	staleAvatar := ""
+	if input.Avatar != nil {
+		authorUpdate = authorUpdate.SetAvatar(*input.Avatar)
+		staleAvatar = a.Avatar
+	} else if input.RemoveAvatar {
+		authorUpdate = authorUpdate.ClearAvatar()
+		staleAvatar = a.Avatar
+	}
+
+	updated, err := authorUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update author: %w", err)
+	}
+
+	if staleAvatar != "" && staleAvatar != updated.Avatar {
+		if err := s.imageService.DeleteImage(ctx, staleAvatar); err != nil {
+			log.Printf("Error deleting avatar '%s': %v", staleAvatar, err)
+		}
+	}
+	s.imageService.resolveAuthors(ctx, updated)
+	return updated, nil
+}
+
+// GetAuthors retrieves a page of authors ordered by name.
+func (s *AuthorService) GetAuthors(ctx context.Context, page, limit int) (*PaginatedAuthors, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10 // Default limit
+	}
+
+	query := s.client.Author.Query()
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		log.Printf("Error counting authors: %v", err)
+		return nil, fmt.Errorf("failed to count authors: %w", err)
+	}
+
+	authors, err := query.
+		Order(ent.Asc(author.FieldName), ent.Asc(author.FieldID)).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		log.Printf("Error fetching paginated authors: %v", err)
+		return nil, fmt.Errorf("failed to fetch authors: %w", err)
+	}
+	s.imageService.resolveAuthors(ctx, authors...)
+
+	return &PaginatedAuthors{
+		Data: authors,
+		Pagination: PaginationMeta{
+			Total:      total,
+			Page:       page,
+			Limit:      limit,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		},
+	}, nil
+}
+
+// GetAuthorBySlug retrieves a single author by slug.
+func (s *AuthorService) GetAuthorBySlug(ctx context.Context, slug string) (*ent.Author, error) {
+	a, err := s.findBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	s.imageService.resolveAuthors(ctx, a)
+	return a, nil
+}
+
+// findBySlug retrieves an author by slug as stored, with its avatar key unresolved.
+func (s *AuthorService) findBySlug(ctx context.Context, slug string) (*ent.Author, error) {
+	a, err := s.client.Author.Query().Where(author.SlugEQ(slug)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("author with slug '%s' %w", slug, ErrNotFound)
+		}
+		log.Printf("Error fetching author by slug '%s': %v", slug, err)
+		return nil, fmt.Errorf("failed to retrieve author: %w", err)
+	}
+	return a, nil
+}
+
+// withBylineSummary loads only the author fields shown in a post's byline.
+func withBylineSummary(q *ent.AuthorQuery) {
+	q.Select(author.FieldName, author.FieldSlug, author.FieldAvatar)
+}
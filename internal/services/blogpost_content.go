@@ -0,0 +1,319 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/schema"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"golang.org/x/net/html"
+)
+
+// ContentMode selects the form in which a post's content is returned.
+type ContentMode string
+
+const (
+	// ContentRaw returns content as written, in its content_format.
+	ContentRaw ContentMode = "raw"
+	// ContentRendered returns content_html, the content rendered to sanitized HTML.
+	ContentRendered ContentMode = "rendered"
+)
+
+// ParseContentMode validates the content query parameter. An empty value selects ContentRaw.
+func ParseContentMode(v string) (ContentMode, error) {
+	switch mode := ContentMode(v); mode {
+	case "":
+		return ContentRaw, nil
+	case ContentRaw, ContentRendered:
+		return mode, nil
+	default:
+		return "", invalidParam("content", "must be '%s' or '%s'", ContentRaw, ContentRendered)
+	}
+}
+
+// parseContentFormat validates a content_format value.
+func parseContentFormat(v string) (blogpost.ContentFormat, error) {
+	format := blogpost.ContentFormat(v)
+	if err := blogpost.ContentFormatValidator(format); err != nil {
+		return "", invalidParam("content_format", "must be markdown, html or plaintext")
+	}
+	return format, nil
+}
+
+// markdown renders GitHub Flavored Markdown. Raw HTML is passed through, as the
+// output is sanitized afterwards.
+var markdown = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+)
+
+// contentPolicy is the sanitizer applied to all rendered content: the user
+// generated content policy, which drops scripts, styles, event handlers and
+// non-http(s) links, keeping only the language class of code blocks.
+var contentPolicy = func() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[a-zA-Z0-9_+-]+$`)).OnElements("code")
+	p.RequireNoReferrerOnLinks(true)
+	return p
+}()
+
+// wordsPerMinute is the reading speed reading times are estimated at.
+const wordsPerMinute = 200
+
+// renderedContent is post content rendered to sanitized HTML, with the table
+// of contents and reading statistics derived from it.
+type renderedContent struct {
+	HTML        string
+	TOC         []schematype.TOCEntry
+	WordCount   int
+	ReadingTime int
+	// Text is the plain text of the content's paragraphs, or of all of it when
+	// it has none, for deriving excerpts.
+	Text string
+}
+
+// renderContent renders content written in format to sanitized HTML, giving
+// its headings anchor IDs.
+func renderContent(content string, format blogpost.ContentFormat) (*renderedContent, error) {
+	var out string
+	switch format {
+	case blogpost.ContentFormatMarkdown:
+		var buf bytes.Buffer
+		if err := markdown.Convert([]byte(content), &buf); err != nil {
+			return nil, fmt.Errorf("failed to render markdown: %w", err)
+		}
+		out = buf.String()
+	case blogpost.ContentFormatHTML:
+		out = content
+	case blogpost.ContentFormatPlaintext:
+		out = plaintextHTML(content)
+	default:
+		return nil, fmt.Errorf("%w: unknown content format '%s'", ErrInvalidInput, format)
+	}
+	return annotateHTML(contentPolicy.Sanitize(out))
+}
+
+// annotateHTML gives every heading of sanitized HTML an id derived from its
+// text, replacing any the author wrote, so links to it survive unrelated
+// edits. It also collects the headings, counts the words and extracts the text.
+func annotateHTML(sanitized string) (*renderedContent, error) {
+	var (
+		out      strings.Builder
+		headings []schematype.TOCEntry
+		used     = map[string]bool{}
+		// paragraphs is the depth of <p> elements the tokenizer is in.
+		paragraphs              int
+		allText, paragraphsText strings.Builder
+		// heading buffers the tag and contents of the heading being read,
+		// which are written once its text, and so its id, is known.
+		heading      *strings.Builder
+		headingStart html.Token
+		headingText  strings.Builder
+	)
+
+	z := html.NewTokenizer(strings.NewReader(sanitized))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if err := z.Err(); err != io.EOF {
+				return nil, fmt.Errorf("failed to read rendered content: %w", err)
+			}
+			break
+		}
+		// Copied, as reading the token's text or attributes unescapes them in place
+		raw := append([]byte(nil), z.Raw()...)
+		token := z.Token()
+		level := headingLevel(token.Data)
+		if tt != html.TextToken && tt != html.CommentToken && blockElements[token.Data] {
+			// Words never run on across blocks
+			allText.WriteString(" ")
+			paragraphsText.WriteString(" ")
+			if token.Data == "p" && tt == html.StartTagToken {
+				paragraphs++
+			} else if token.Data == "p" && tt == html.EndTagToken && paragraphs > 0 {
+				paragraphs--
+			}
+		}
+
+		switch {
+		case tt == html.StartTagToken && level > 0 && heading == nil:
+			heading = &strings.Builder{}
+			headingStart = token
+			headingText.Reset()
+			continue
+		case tt == html.EndTagToken && level > 0 && heading != nil:
+			text := strings.Join(strings.Fields(headingText.String()), " ")
+			id := headingID(text, used)
+			headings = append(headings, schematype.TOCEntry{ID: id, Text: text, Level: level})
+			attrs := []html.Attribute{{Key: "id", Val: id}}
+			for _, attr := range headingStart.Attr {
+				if attr.Key != "id" {
+					attrs = append(attrs, attr)
+				}
+			}
+			headingStart.Attr = attrs
+			out.WriteString(headingStart.String())
+			out.WriteString(heading.String())
+			out.Write(raw)
+			heading = nil
+			continue
+		case tt == html.TextToken:
+			allText.WriteString(token.Data)
+			if paragraphs > 0 {
+				paragraphsText.WriteString(token.Data)
+			}
+			if heading != nil {
+				headingText.WriteString(token.Data)
+			}
+		}
+
+		if heading != nil {
+			heading.Write(raw)
+		} else {
+			out.Write(raw)
+		}
+	}
+	if heading != nil {
+		// An unterminated heading is written as it was, without an id
+		out.WriteString(headingStart.String())
+		out.WriteString(heading.String())
+	}
+
+	words := len(strings.Fields(allText.String()))
+	text := paragraphsText.String()
+	if strings.TrimSpace(text) == "" {
+		text = allText.String()
+	}
+	return &renderedContent{
+		Text:        strings.Join(strings.Fields(text), " "),
+		HTML:        out.String(),
+		TOC:         nestTOC(headings),
+		WordCount:   words,
+		ReadingTime: (words + wordsPerMinute - 1) / wordsPerMinute,
+	}, nil
+}
+
+// blockElements are the elements whose boundaries separate words in the extracted text.
+var blockElements = map[string]bool{
+	"p": true, "br": true, "hr": true, "div": true, "blockquote": true, "pre": true,
+	"ul": true, "ol": true, "li": true, "dl": true, "dt": true, "dd": true,
+	"table": true, "tr": true, "th": true, "td": true, "figure": true, "figcaption": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+// headingLevel returns the level of an h1 to h6 tag name, or 0 for other tags.
+func headingLevel(name string) int {
+	if len(name) == 2 && name[0] == 'h' && name[1] >= '1' && name[1] <= '6' {
+		return int(name[1] - '0')
+	}
+	return 0
+}
+
+// headingID derives an unused anchor id from a heading's text, numbering
+// repeated headings "setup", "setup-1", "setup-2" in order of appearance.
+func headingID(text string, used map[string]bool) string {
+	base := utils.GenerateSlug(text)
+	if base == "" {
+		base = "section"
+	}
+	id := base
+	for n := 1; used[id]; n++ {
+		id = fmt.Sprintf("%s-%d", base, n)
+	}
+	used[id] = true
+	return id
+}
+
+// nestTOC nests each heading under the nearest preceding heading of a higher level.
+func nestTOC(headings []schematype.TOCEntry) []schematype.TOCEntry {
+	var toc []schematype.TOCEntry
+	for i := 0; i < len(headings); {
+		entry := headings[i]
+		j := i + 1
+		for j < len(headings) && headings[j].Level > entry.Level {
+			j++
+		}
+		entry.Children = nestTOC(headings[i+1 : j])
+		toc = append(toc, entry)
+		i = j
+	}
+	return toc
+}
+
+// paragraphBreak matches the blank lines separating plain text paragraphs.
+var paragraphBreak = regexp.MustCompile(`\n[ \t]*\n\s*`)
+
+// plaintextHTML escapes plain text and wraps its paragraphs, keeping line breaks.
+func plaintextHTML(content string) string {
+	content = strings.TrimSpace(strings.ReplaceAll(content, "\r\n", "\n"))
+	var b strings.Builder
+	for _, para := range paragraphBreak.Split(content, -1) {
+		if para == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(para), "\n", "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
+
+// ensureRendered fills in the rendered content, table of contents and reading
+// statistics of a post that predates them, without saving them.
+func ensureRendered(post *ent.BlogPost) error {
+	if post.ContentHTML != "" || post.Content == "" {
+		return nil
+	}
+	rendered, err := renderContent(post.Content, post.ContentFormat)
+	if err != nil {
+		return err
+	}
+	post.ContentHTML, post.Toc = rendered.HTML, rendered.TOC
+	post.WordCount, post.ReadingTime = rendered.WordCount, rendered.ReadingTime
+	return nil
+}
+
+// RenderPosts renders the content of posts that predate content_html, or of
+// every post when all is set, as after a change to the sanitizer policy.
+// Posts keep their update time. It returns the number of posts rendered.
+func (s *BlogPostService) RenderPosts(ctx context.Context, all bool) (int, error) {
+	query := s.client.BlogPost.Query()
+	if !all {
+		query = query.Where(blogpost.Or(blogpost.ContentHTMLIsNil(), blogpost.ContentHTMLEQ("")))
+	}
+	posts, err := query.
+		Select(blogpost.FieldContent, blogpost.FieldContentFormat, blogpost.FieldUpdateTime).
+		All(schema.SkipSoftDelete(ctx)) // trashed posts may be restored
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch blog posts: %w", err)
+	}
+
+	for i, p := range posts {
+		rendered, err := renderContent(p.Content, p.ContentFormat)
+		if err != nil {
+			return i, fmt.Errorf("failed to render blog post %d: %w", p.ID, err)
+		}
+		err = s.client.BlogPost.UpdateOneID(p.ID).
+			SetContentHTML(rendered.HTML).
+			SetToc(rendered.TOC).
+			SetWordCount(rendered.WordCount).
+			SetReadingTime(rendered.ReadingTime).
+			SetUpdateTime(p.UpdateTime).
+			Exec(ctx)
+		if err != nil {
+			return i, fmt.Errorf("failed to update blog post %d: %w", p.ID, err)
+		}
+	}
+	return len(posts), nil
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+)
+
+// CursorMeta holds the opaque cursors for fetching the neighbouring pages of a listing.
+// A cursor is omitted when there is no page in that direction.
+type CursorMeta struct {
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+// postCursor is the keyset position of a post in the default listing order
+// (create_time descending, then id descending).
+type postCursor struct {
+	CreateTime time.Time `json:"t"`
+	ID         int       `json:"id"`
+}
+
+// encodeCursor returns the opaque cursor pointing at post.
+func encodeCursor(post *ent.BlogPost) string {
+	b, _ := json.Marshal(postCursor{CreateTime: post.CreateTime, ID: post.ID})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor parses a cursor produced by encodeCursor, received in param.
+func decodeCursor(param, cursor string) (*postCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalidParam(param, "malformed cursor")
+	}
+	var c postCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID < 1 || c.CreateTime.IsZero() {
+		return nil, invalidParam(param, "malformed cursor")
+	}
+	return &c, nil
+}
+
+// cursorParam names the cursor parameter set in params.
+func cursorParam(params ListBlogPostsParams) string {
+	if params.After != "" {
+		return "after"
+	}
+	return "before"
+}
+
+// afterCursor matches posts that come after c in the default listing order.
+func afterCursor(c *postCursor) predicate.BlogPost {
+	return blogpost.Or(
+		blogpost.CreateTimeLT(c.CreateTime),
+		blogpost.And(blogpost.CreateTimeEQ(c.CreateTime), blogpost.IDLT(c.ID)),
+	)
+}
+
+// beforeCursor matches posts that come before c in the default listing order.
+func beforeCursor(c *postCursor) predicate.BlogPost {
+	return blogpost.Or(
+		blogpost.CreateTimeGT(c.CreateTime),
+		blogpost.And(blogpost.CreateTimeEQ(c.CreateTime), blogpost.IDGT(c.ID)),
+	)
+}
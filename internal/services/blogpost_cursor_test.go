@@ -0,0 +1,82 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		post *ent.BlogPost
+	}{
+		{
+			name: "utc",
+			post: &ent.BlogPost{ID: 1, CreateTime: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "nanoseconds are kept",
+			post: &ent.BlogPost{ID: 42, CreateTime: time.Date(2024, 3, 1, 12, 0, 0, 123456789, time.UTC)},
+		},
+		{
+			name: "other time zone",
+			post: &ent.BlogPost{ID: 7, CreateTime: time.Date(2023, 12, 31, 23, 59, 59, 0, time.FixedZone("EAT", 3*60*60))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := encodeCursor(tt.post)
+			c, err := decodeCursor("after", cursor)
+			if err != nil {
+				t.Fatalf("decodeCursor(%q) error = %v", cursor, err)
+			}
+			if c.ID != tt.post.ID || !c.CreateTime.Equal(tt.post.CreateTime) {
+				t.Errorf("decodeCursor(%q) = %+v, want id %d at %v", cursor, c, tt.post.ID, tt.post.CreateTime)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejectsTampering(t *testing.T) {
+	raw := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	valid := encodeCursor(&ent.BlogPost{ID: 3, CreateTime: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)})
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "empty", cursor: ""},
+		{name: "not base64", cursor: "not a cursor!"},
+		{name: "padded base64", cursor: base64.URLEncoding.EncodeToString([]byte(`{"t":"2024-03-01T12:00:00Z","id":3}`))},
+		{name: "truncated", cursor: valid[:len(valid)-4]},
+		{name: "not json", cursor: raw("hello")},
+		{name: "json array", cursor: raw(`[1,2]`)},
+		{name: "missing id", cursor: raw(`{"t":"2024-03-01T12:00:00Z"}`)},
+		{name: "zero id", cursor: raw(`{"t":"2024-03-01T12:00:00Z","id":0}`)},
+		{name: "negative id", cursor: raw(`{"t":"2024-03-01T12:00:00Z","id":-1}`)},
+		{name: "id as string", cursor: raw(`{"t":"2024-03-01T12:00:00Z","id":"3"}`)},
+		{name: "id overflow", cursor: raw(`{"t":"2024-03-01T12:00:00Z","id":1e400}`)},
+		{name: "missing time", cursor: raw(`{"id":3}`)},
+		{name: "malformed time", cursor: raw(`{"t":"yesterday","id":3}`)},
+		{name: "zero time", cursor: raw(`{"t":"0001-01-01T00:00:00Z","id":3}`)},
+		{name: "sql in time", cursor: raw(`{"t":"2024-03-01' OR '1'='1","id":3}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := decodeCursor("before", tt.cursor)
+			if err == nil {
+				t.Fatalf("decodeCursor(%q) = %+v, want an error", tt.cursor, c)
+			}
+			var paramErr *ParamError
+			if !errors.As(err, &paramErr) || paramErr.Param != "before" {
+				t.Errorf("decodeCursor(%q) error = %v, want a ParamError for before", tt.cursor, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+)
+
+// maxExcerptLength is the longest excerpt the schema accepts, in characters.
+const maxExcerptLength = 160
+
+// excerptEllipsis ends excerpts shortened to fit maxExcerptLength.
+const excerptEllipsis = "…"
+
+// deriveExcerpt returns the whole words of text that fit maxExcerptLength,
+// ending with an ellipsis when shortened. The title is used instead when the
+// content has no text, such as a post made of a single image; a ParamError
+// for the excerpt is returned when neither has any.
+func deriveExcerpt(text, title string) (string, error) {
+	text = strings.Join(strings.Fields(text), " ")
+	if text == "" {
+		text = strings.Join(strings.Fields(title), " ")
+	}
+	if text == "" {
+		return "", invalidParam("excerpt", "is required when neither the content nor the title has text")
+	}
+	if utf8.RuneCountInString(text) <= maxExcerptLength {
+		return text, nil
+	}
+
+	// limit is the byte offset just past the characters that fit before
+	// the ellipsis
+	limit, n := len(text), 0
+	for i := range text {
+		if n == maxExcerptLength-utf8.RuneCountInString(excerptEllipsis) {
+			limit = i
+			break
+		}
+		n++
+	}
+	// A space at or before limit ends the last word that fits
+	cut := strings.LastIndexByte(text[:limit+1], ' ')
+	if cut <= 0 {
+		// A single word longer than the limit is cut between characters
+		cut = limit
+	}
+	return strings.TrimRight(text[:cut], " ,;:.-") + excerptEllipsis, nil
+}
+
+// validateExcerpt checks an excerpt written by an author against the schema.
+func validateExcerpt(excerpt string) error {
+	if err := blogpost.ExcerptValidator(excerpt); err != nil {
+		return invalidParam("excerpt", "must be at most %d characters", maxExcerptLength)
+	}
+	return nil
+}
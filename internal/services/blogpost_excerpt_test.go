@@ -0,0 +1,143 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	_ "github.com/AdongoJr2/technoprise-backend/ent/runtime"
+)
+
+func TestDeriveExcerpt(t *testing.T) {
+	// words builds n words of the given length, separated by spaces
+	words := func(n int, word string) string {
+		return strings.TrimSpace(strings.Repeat(word+" ", n))
+	}
+
+	tests := []struct {
+		name    string
+		text    string
+		title   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "short text is kept",
+			text: "Hello,\n\n  world",
+			want: "Hello, world",
+		},
+		{
+			name:  "title when text is empty",
+			text:  " \n ",
+			title: "A  photo post",
+			want:  "A photo post",
+		},
+		{
+			name:    "no text in content or title",
+			text:    " \n ",
+			title:   " \t ",
+			wantErr: true,
+		},
+		{
+			name: "exactly the limit is kept",
+			text: strings.Repeat("a", maxExcerptLength),
+			want: strings.Repeat("a", maxExcerptLength),
+		},
+		{
+			// 40 three-letter words and their spaces take 159 characters,
+			// leaving exactly room for the ellipsis
+			name: "cut after the last whole word",
+			text: words(53, "abc"),
+			want: words(40, "abc") + excerptEllipsis,
+		},
+		{
+			// The 40th word would end on the 160th character, where the
+			// ellipsis goes
+			name: "word ending past the ellipsis is dropped",
+			text: words(39, "abc") + " abcd " + words(10, "abc"),
+			want: words(39, "abc") + excerptEllipsis,
+		},
+		{
+			name: "trailing punctuation is trimmed",
+			text: words(38, "abc") + " abc, " + words(10, "abc"),
+			want: words(38, "abc") + " abc" + excerptEllipsis,
+		},
+		{
+			name: "single long word is cut between characters",
+			text: strings.Repeat("a", 200),
+			want: strings.Repeat("a", maxExcerptLength-1) + excerptEllipsis,
+		},
+		{
+			// Cyrillic letters take two bytes each but count once
+			name: "multibyte text is counted in characters",
+			text: words(39, "абв") + " гдеж " + words(10, "абв"),
+			want: words(39, "абв") + excerptEllipsis,
+		},
+		{
+			name: "multibyte text under the limit in characters is kept",
+			text: words(40, "абв"),
+			want: words(40, "абв"),
+		},
+		{
+			name: "single long multibyte word",
+			text: strings.Repeat("語", 200),
+			want: strings.Repeat("語", maxExcerptLength-1) + excerptEllipsis,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := deriveExcerpt(tt.text, tt.title)
+			if tt.wantErr {
+				var paramErr *ParamError
+				if !errors.As(err, &paramErr) || paramErr.Param != "excerpt" {
+					t.Fatalf("deriveExcerpt() error = %v, want a ParamError for excerpt", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("deriveExcerpt() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("deriveExcerpt() = %q, want %q", got, tt.want)
+			}
+			if n := utf8.RuneCountInString(got); n > maxExcerptLength {
+				t.Errorf("deriveExcerpt() is %d characters, more than %d", n, maxExcerptLength)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("deriveExcerpt() = %q, not valid UTF-8", got)
+			}
+			if err := validateExcerpt(got); err != nil {
+				t.Errorf("validateExcerpt(deriveExcerpt()) = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateExcerpt(t *testing.T) {
+	tests := []struct {
+		name    string
+		excerpt string
+		wantErr bool
+	}{
+		{name: "short", excerpt: "A short excerpt"},
+		{name: "limit in ASCII", excerpt: strings.Repeat("a", maxExcerptLength)},
+		{name: "limit in multibyte characters", excerpt: strings.Repeat("é", maxExcerptLength)},
+		{name: "over the limit", excerpt: strings.Repeat("a", maxExcerptLength+1), wantErr: true},
+		{name: "over the limit in multibyte characters", excerpt: strings.Repeat("é", maxExcerptLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateExcerpt(tt.excerpt)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateExcerpt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var paramErr *ParamError
+			if err != nil && (!errors.As(err, &paramErr) || paramErr.Param != "excerpt") {
+				t.Errorf("validateExcerpt() error = %v, want a ParamError for excerpt", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+)
+
+// Edge names accepted in the fields parameter of listings.
+const (
+	bylineEdge   = "byline"
+	categoryEdge = "category"
+	tagsEdge     = "tags"
+	// mediaEdge is loaded along with the image field rather than requested by name.
+	mediaEdge = "media"
+)
+
+// ParamError reports an invalid query parameter or input field. It wraps
+// ErrInvalidInput, so callers can tell which parameter was at fault.
+type ParamError struct {
+	Param   string
+	Message string
+}
+
+// Error implements the error interface for ParamError.
+func (e *ParamError) Error() string {
+	return fmt.Sprintf("%v: %s: %s", ErrInvalidInput, e.Param, e.Message)
+}
+
+// Unwrap makes errors.Is(err, ErrInvalidInput) hold for a ParamError.
+func (e *ParamError) Unwrap() error {
+	return ErrInvalidInput
+}
+
+// invalidParam returns a ParamError for param with a formatted message.
+func invalidParam(param, format string, args ...interface{}) error {
+	return &ParamError{Param: param, Message: fmt.Sprintf(format, args...)}
+}
+
+// sortableFields lists the fields listings may be sorted by.
+var sortableFields = map[string]bool{
+	blogpost.FieldTitle:       true,
+	blogpost.FieldPublishedAt: true,
+	blogpost.FieldCreateTime:  true,
+	blogpost.FieldUpdateTime:  true,
+	blogpost.FieldDeletedAt:   true,
+}
+
+// listFields lists the fields returned by listings when no fields parameter is given.
+var listFields = []string{
+	blogpost.FieldID,
+	blogpost.FieldTitle,
+	blogpost.FieldSlug,
+	blogpost.FieldExcerpt,
+	blogpost.FieldCreateTime,
+	blogpost.FieldUpdateTime,
+	blogpost.FieldPublishedAt,
+	blogpost.FieldImage,
+	blogpost.FieldImageVariants,
+	blogpost.FieldStatus,
+	blogpost.FieldReadingTime,
+	blogpost.FieldDeletedAt,
+}
+
+// selectableFields lists the fields and edges a fields parameter may request.
+var selectableFields = map[string]bool{
+	blogpost.FieldID:            true,
+	blogpost.FieldTitle:         true,
+	blogpost.FieldSlug:          true,
+	blogpost.FieldExcerpt:       true,
+	blogpost.FieldContent:       true,
+	blogpost.FieldContentFormat: true,
+	blogpost.FieldWordCount:     true,
+	blogpost.FieldReadingTime:   true,
+	blogpost.FieldCreateTime:    true,
+	blogpost.FieldUpdateTime:    true,
+	blogpost.FieldPublishedAt:   true,
+	blogpost.FieldImage:         true,
+	blogpost.FieldStatus:        true,
+	blogpost.FieldDeletedAt:     true,
+	bylineEdge:                  true,
+	categoryEdge:                true,
+	tagsEdge:                    true,
+}
+
+// listSelection is the resolved set of columns and edges a listing loads.
+type listSelection struct {
+	columns []string
+	edges   map[string]bool
+	// keys limits the JSON output to these keys; nil means no limit.
+	keys map[string]bool
+}
+
+// resolveFields validates a sparse fieldset and maps it onto columns and edges.
+// The id is always included. Without fields, the default listing selection is used.
+func resolveFields(fields []string) (*listSelection, error) {
+	if len(fields) == 0 {
+		return &listSelection{
+			columns: listFields,
+			edges:   map[string]bool{bylineEdge: true, categoryEdge: true, tagsEdge: true, mediaEdge: true},
+		}, nil
+	}
+
+	sel := &listSelection{
+		columns: []string{blogpost.FieldID},
+		edges:   map[string]bool{},
+		keys:    map[string]bool{blogpost.FieldID: true},
+	}
+	for _, f := range fields {
+		if !selectableFields[f] {
+			return nil, invalidParam("fields", "unknown field '%s'", f)
+		}
+		switch f {
+		case blogpost.FieldID:
+		case bylineEdge, categoryEdge, tagsEdge:
+			sel.edges[f] = true
+			sel.keys["edges"] = true
+		case blogpost.FieldImage:
+			// The image is returned with its variants and dimensions
+			if !sel.keys[f] {
+				sel.columns = append(sel.columns, blogpost.FieldImage, blogpost.FieldImageVariants)
+				sel.edges[mediaEdge] = true
+				sel.keys["edges"] = true
+			}
+		default:
+			if !sel.keys[f] {
+				sel.columns = append(sel.columns, f)
+			}
+		}
+		sel.keys[f] = true
+	}
+	return sel, nil
+}
+
+// withColumn adds a column the listing needs internally, such as a cursor key.
+func (sel *listSelection) withColumn(column string) {
+	for _, c := range sel.columns {
+		if c == column {
+			return
+		}
+	}
+	sel.columns = append(sel.columns, column)
+}
+
+// parseSort converts sort keys such as "published_at" or "-title" into
+// order options. A leading "-" sorts descending. Returns nil for no keys.
+func parseSort(keys []string) ([]blogpost.OrderOption, error) {
+	orders := make([]blogpost.OrderOption, 0, len(keys)+1)
+	seen := make(map[string]bool, len(keys))
+	desc := false
+
+	for _, key := range keys {
+		field := strings.TrimPrefix(key, "-")
+		if !sortableFields[field] {
+			return nil, invalidParam("sort", "cannot sort by '%s'", field)
+		}
+		if seen[field] {
+			return nil, invalidParam("sort", "duplicate sort key '%s'", field)
+		}
+		seen[field] = true
+
+		desc = strings.HasPrefix(key, "-")
+		if desc {
+			orders = append(orders, sql.OrderByField(field, sql.OrderDesc(), sql.OrderNullsLast()).ToFunc())
+		} else {
+			orders = append(orders, sql.OrderByField(field, sql.OrderNullsLast()).ToFunc())
+		}
+	}
+
+	if len(orders) == 0 {
+		return nil, nil
+	}
+
+	// Break ties by id in the direction of the last key so pages are deterministic
+	if desc {
+		orders = append(orders, blogpost.ByID(sql.OrderDesc()))
+	} else {
+		orders = append(orders, blogpost.ByID())
+	}
+	return orders, nil
+}
+
+// isDefaultSort reports whether the sort keys describe the default listing
+// order, the only order cursors are defined for.
+func isDefaultSort(keys []string) bool {
+	return len(keys) == 0 || (len(keys) == 1 && keys[0] == "-"+blogpost.FieldCreateTime)
+}
+
+// MarshalJSON limits the output to the requested fields when a sparse fieldset was used.
+func (item *BlogPostListItem) MarshalJSON() ([]byte, error) {
+	type plain BlogPostListItem
+	b, err := json.Marshal((*plain)(item))
+	if err != nil || item.keys == nil {
+		return b, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(b, &all); err != nil {
+		return nil, err
+	}
+	for key := range all {
+		switch key {
+		case "headline", "rank":
+			// Search metadata is always kept
+		default:
+			if !item.keys[key] {
+				delete(all, key)
+			}
+		}
+	}
+	return json.Marshal(all)
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/postrevision"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// RevisionDiff is the difference between two revisions of a post.
+type RevisionDiff struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+	// Changes holds a unified diff of each field that differs, in field order.
+	Changes []FieldDiff `json:"changes"`
+}
+
+// FieldDiff is a unified diff of one field of two revisions.
+type FieldDiff struct {
+	Field string `json:"field"`
+	Diff  string `json:"diff"`
+}
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// ListRevisions returns the revisions of the post identified by slug, newest
+// first, without their content.
+func (s *BlogPostService) ListRevisions(ctx context.Context, slug string) ([]*ent.PostRevision, error) {
+	post, err := s.findBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := post.QueryRevisions().
+		Select(
+			postrevision.FieldID,
+			postrevision.FieldNumber,
+			postrevision.FieldTitle,
+			postrevision.FieldExcerpt,
+			postrevision.FieldExcerptGenerated,
+			postrevision.FieldContentFormat,
+			postrevision.FieldRestoredFrom,
+			postrevision.FieldCreateTime,
+		).
+		WithEditor(withEditorSummary).
+		Order(ent.Desc(postrevision.FieldNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// GetRevision returns revision number of the post identified by slug.
+func (s *BlogPostService) GetRevision(ctx context.Context, slug string, number int) (*ent.PostRevision, error) {
+	post, err := s.findBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.revision(ctx, post, number)
+}
+
+// DiffRevisions compares revisions from and to of the post identified by slug.
+func (s *BlogPostService) DiffRevisions(ctx context.Context, slug string, from, to int) (*RevisionDiff, error) {
+	post, err := s.findBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.revision(ctx, post, from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.revision(ctx, post, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &RevisionDiff{From: from, To: to, Changes: []FieldDiff{}}
+	fields := []struct{ name, a, b string }{
+		{blogpost.FieldTitle, a.Title, b.Title},
+		{blogpost.FieldExcerpt, a.Excerpt, b.Excerpt},
+		{blogpost.FieldContentFormat, string(a.ContentFormat), string(b.ContentFormat)},
+		{blogpost.FieldContent, a.Content, b.Content},
+	}
+	for _, f := range fields {
+		if f.a == f.b {
+			continue
+		}
+		text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(f.a),
+			B:        difflib.SplitLines(f.b),
+			FromFile: fmt.Sprintf("revision %d", from),
+			ToFile:   fmt.Sprintf("revision %d", to),
+			Context:  diffContext,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff %s: %w", f.name, err)
+		}
+		diff.Changes = append(diff.Changes, FieldDiff{Field: f.name, Diff: text})
+	}
+	return diff, nil
+}
+
+// RestoreRevision sets the title, excerpt and content of the post identified
+// by slug back to those of revision number, recording the result as a new
+// revision. editorID is the user restoring it.
+func (s *BlogPostService) RestoreRevision(ctx context.Context, slug string, number int, editorID *int) (*ent.BlogPost, error) {
+	post, err := s.findBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.revision(ctx, post, number)
+	if err != nil {
+		return nil, err
+	}
+
+	format := string(rev.ContentFormat)
+	excerpt := rev.Excerpt
+	if rev.ExcerptGenerated {
+		// Derived again, so it keeps following the content
+		excerpt = ""
+	}
+	return s.UpdateBlogPost(ctx, slug, UpdateBlogPostInput{
+		Title:         &rev.Title,
+		Excerpt:       &excerpt,
+		Content:       &rev.Content,
+		ContentFormat: &format,
+		EditorID:      editorID,
+		restoredFrom:  &rev.Number,
+	})
+}
+
+// revision loads revision number of post.
+func (s *BlogPostService) revision(ctx context.Context, post *ent.BlogPost, number int) (*ent.PostRevision, error) {
+	rev, err := post.QueryRevisions().
+		Where(postrevision.NumberEQ(number)).
+		WithEditor(withEditorSummary).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("revision %d of blog post '%s' %w", number, post.Slug, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to retrieve revision: %w", err)
+	}
+	return rev, nil
+}
+
+// recordRevision snapshots the written fields of post as its next revision,
+// within tx. A post that predates revision history first gets its previous
+// state, before, recorded as revision 1, so the change can be undone.
+func recordRevision(ctx context.Context, tx *ent.Tx, before, post *ent.BlogPost, editorID, restoredFrom *int) error {
+	last, err := tx.PostRevision.Query().
+		Where(postrevision.HasPostWith(blogpost.ID(post.ID))).
+		Order(ent.Desc(postrevision.FieldNumber)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to retrieve latest revision: %w", err)
+	}
+
+	number := 1
+	if last != nil {
+		number = last.Number + 1
+	} else if before != nil {
+		err := newRevision(tx, before, number).
+			SetCreateTime(before.UpdateTime).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to record previous revision: %w", err)
+		}
+		number++
+	}
+
+	err = newRevision(tx, post, number).
+		SetNillableEditorID(editorID).
+		SetNillableRestoredFrom(restoredFrom).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record revision: %w", err)
+	}
+	return nil
+}
+
+// newRevision starts creating revision number from the written fields of post.
+func newRevision(tx *ent.Tx, post *ent.BlogPost, number int) *ent.PostRevisionCreate {
+	return tx.PostRevision.Create().
+		SetPostID(post.ID).
+		SetNumber(number).
+		SetTitle(post.Title).
+		SetExcerpt(post.Excerpt).
+		SetExcerptGenerated(post.ExcerptGenerated).
+		SetContent(post.Content).
+		SetContentFormat(postrevision.ContentFormat(post.ContentFormat))
+}
+
+// revisionChanged reports whether an update changed any field revisions record.
+func revisionChanged(before, after *ent.BlogPost) bool {
+	return before.Title != after.Title ||
+		before.Excerpt != after.Excerpt ||
+		before.ExcerptGenerated != after.ExcerptGenerated ||
+		before.Content != after.Content ||
+		before.ContentFormat != after.ContentFormat
+}
+
+// withEditorSummary loads only the name of a revision's editor.
+func withEditorSummary(q *ent.UserQuery) {
+	q.Select(user.FieldName)
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"golang.org/x/net/html"
+)
+
+const (
+	// searchConfig is the Postgres text search configuration; it must match the
+	// one the search_vector column is generated with in config.ConnectDB.
+	searchConfig = "english"
+	// searchVectorColumn is the generated tsvector column maintained by config.ConnectDB.
+	searchVectorColumn = "search_vector"
+	// headlineColumn is the alias of the highlighted snippet selected for search hits.
+	headlineColumn = "headline"
+	// rankColumn is the alias of the relevance score selected for search hits.
+	rankColumn = "rank"
+	// headlineStart and headlineStop delimit matches in ts_headline output until
+	// headlineHTML turns them into <mark> tags. They are private use characters,
+	// so they survive escaping and are not expected in post content.
+	headlineStart = "\uE000"
+	headlineStop  = "\uE001"
+	// headlineOptions controls the ts_headline snippets returned for search hits.
+	headlineOptions = "StartSel=" + headlineStart + ", StopSel=" + headlineStop +
+		", MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" … \""
+)
+
+// buildTSQuery converts a user search string into to_tsquery syntax.
+// Quoted text becomes a phrase ("fast api" -> fast <-> api), a trailing *
+// makes a prefix match (deploy* -> deploy:*) and all terms must match.
+// Anything other than letters and digits is treated as a separator, so the
+// result never contains tsquery operators supplied by the user.
+func buildTSQuery(search string) string {
+	var terms []string
+
+	addTerm := func(text string, prefix bool) {
+		words := strings.FieldsFunc(text, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if len(words) == 0 {
+			return
+		}
+		if prefix {
+			words[len(words)-1] += ":*"
+		}
+		if len(words) == 1 {
+			terms = append(terms, words[0])
+			return
+		}
+		terms = append(terms, "("+strings.Join(words, " <-> ")+")")
+	}
+
+	for i, part := range strings.Split(search, `"`) {
+		// Odd parts sit between quotes
+		if i%2 == 1 {
+			addTerm(part, false)
+			continue
+		}
+		for _, word := range strings.Fields(part) {
+			addTerm(word, strings.HasSuffix(word, "*"))
+		}
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+// tsQueryExpr writes to_tsquery(<config>, <tsQuery>) to the builder.
+func tsQueryExpr(b *sql.Builder, tsQuery string) {
+	b.WriteString("to_tsquery(").Arg(searchConfig).WriteString("::regconfig, ").Arg(tsQuery).WriteString(")")
+}
+
+// matchesSearch matches posts whose search vector satisfies tsQuery.
+func matchesSearch(tsQuery string) predicate.BlogPost {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(searchVectorColumn)).WriteString(" @@ ")
+			tsQueryExpr(b, tsQuery)
+		}))
+	}
+}
+
+// byRelevance orders search hits by ts_rank, most relevant first.
+func byRelevance(tsQuery string) blogpost.OrderOption {
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank(").Ident(s.C(searchVectorColumn)).WriteString(", ")
+			tsQueryExpr(b, tsQuery)
+			b.WriteString(") DESC")
+		}))
+	}
+}
+
+// withSearchHighlights selects the rank and a highlighted snippet of the
+// sanitized content_html for each hit; see headlineHTML. Rows stored before
+// content_html was are highlighted in their raw content instead, whose markup
+// headlineHTML drops all the same.
+func withSearchHighlights(tsQuery string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank(").Ident(s.C(searchVectorColumn)).WriteString(", ")
+			tsQueryExpr(b, tsQuery)
+			b.WriteString(")")
+		}), rankColumn)
+		s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_headline(").Arg(searchConfig).WriteString("::regconfig, ")
+			b.WriteString("COALESCE(NULLIF(").Ident(s.C(blogpost.FieldContentHTML)).WriteString(", ''), ")
+			b.Ident(s.C(blogpost.FieldContent)).WriteString("), ")
+			tsQueryExpr(b, tsQuery)
+			b.WriteString(", ").Arg(headlineOptions).WriteString(")")
+		}), headlineColumn)
+	}
+}
+
+// searchHighlights reads the values selected by withSearchHighlights from a post.
+func searchHighlights(post *ent.BlogPost) (string, float64) {
+	var headline string
+	var rank float64
+
+	if v, err := post.Value(headlineColumn); err == nil {
+		switch h := v.(type) {
+		case string:
+			headline = headlineHTML(h)
+		case []byte:
+			headline = headlineHTML(string(h))
+		}
+	}
+	if v, err := post.Value(rankColumn); err == nil {
+		switch r := v.(type) {
+		case float64:
+			rank = r
+		case float32:
+			rank = float64(r)
+		case []byte:
+			rank, _ = strconv.ParseFloat(string(r), 64)
+		}
+	}
+	return headline, rank
+}
+
+// headlineHTML turns a ts_headline fragment of content_html into escaped text
+// with matches wrapped in <mark>. Fragments may cut elements in half, so tags
+// are dropped rather than kept, and nothing but <mark> reaches the client.
+func headlineHTML(fragment string) string {
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(fragment))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			text := strings.Join(strings.Fields(b.String()), " ")
+			text = strings.ReplaceAll(text, headlineStart, "<mark>")
+			return strings.ReplaceAll(text, headlineStop, "</mark>")
+		case html.TextToken:
+			b.WriteString(html.EscapeString(string(z.Text())))
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			// Keep words in neighbouring blocks apart
+			b.WriteByte(' ')
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+)
+
+func TestHeadlineHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		fragment string
+		want     string
+	}{
+		{
+			name:     "marks matches",
+			fragment: "<p>Deploying the " + headlineStart + "api" + headlineStop + " safely</p>",
+			want:     "Deploying the <mark>api</mark> safely",
+		},
+		{
+			name:     "keeps escaped text escaped",
+			fragment: "<p>use &lt;b&gt; &amp; " + headlineStart + "tags" + headlineStop + "</p>",
+			want:     "use &lt;b&gt; &amp; <mark>tags</mark>",
+		},
+		{
+			name:     "drops tags cut by the fragment",
+			fragment: "<a href=\"https://example.com\" rel=\"nofollow\">" + headlineStart + "link" + headlineStop + "</a></p><p>next",
+			want:     "<mark>link</mark> next",
+		},
+		{
+			name:     "escapes script text",
+			fragment: "<script>alert(\"" + headlineStart + "x" + headlineStop + "\")</script>",
+			want:     "alert(&#34;<mark>x</mark>&#34;)",
+		},
+		{
+			name:     "escapes stray markup",
+			fragment: "a < b and " + headlineStart + "c" + headlineStop + " > d",
+			want:     "a &lt; b and <mark>c</mark> &gt; d",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := headlineHTML(tt.fragment); got != tt.want {
+				t.Errorf("headlineHTML(%q) = %q, want %q", tt.fragment, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeadlineHTMLScriptInContent(t *testing.T) {
+	formats := []blogpost.ContentFormat{
+		blogpost.ContentFormatMarkdown,
+		blogpost.ContentFormatHTML,
+		blogpost.ContentFormatPlaintext,
+	}
+	content := "Before <script>alert('xss')</script> after <img src=x onerror=alert(1)> done"
+	for _, format := range formats {
+		t.Run(string(format), func(t *testing.T) {
+			rendered, err := renderContent(content, format)
+			if err != nil {
+				t.Fatalf("renderContent: %v", err)
+			}
+			// ts_headline keeps the tags of content_html and delimits matches
+			fragment := strings.Replace(rendered.HTML, "after", headlineStart+"after"+headlineStop, 1)
+			got := headlineHTML(fragment)
+
+			withoutMarks := strings.NewReplacer("<mark>", "", "</mark>", "").Replace(got)
+			if strings.ContainsAny(withoutMarks, "<>") {
+				t.Errorf("headline %q contains markup other than <mark>", got)
+			}
+			if !strings.Contains(got, "<mark>after</mark>") {
+				t.Errorf("headline %q does not mark the match", got)
+			}
+		})
+	}
+}
+
+func TestBuildTSQuery(t *testing.T) {
+	tests := []struct {
+		search string
+		want   string
+	}{
+		{"", ""},
+		{"   ", ""},
+		{"!!! ... ???", ""},
+		{`"" "&|!"`, ""},
+		{"*", ""},
+		{"go", "go"},
+		{"fast api", "fast & api"},
+		{`"fast api"`, "(fast <-> api)"},
+		{`"fast api" deploy*`, "(fast <-> api) & deploy:*"},
+		{"deploy*", "deploy:*"},
+		{"c++ & rust | go", "c & rust & go"},
+		{"it's", "(it <-> s)"},
+		{"don't*", "(don <-> t:*)"},
+		{"a:* & !b", "a:* & b"},
+		{`unterminated "quote here`, "unterminated & (quote <-> here)"},
+		{"café naïve", "café & naïve"},
+	}
+	for _, tt := range tests {
+		if got := buildTSQuery(tt.search); got != tt.want {
+			t.Errorf("buildTSQuery(%q) = %q, want %q", tt.search, got, tt.want)
+		}
+	}
+}
+
+func TestWithSearchHighlights(t *testing.T) {
+	s := sql.Dialect(dialect.Postgres).Select(blogpost.FieldID).From(sql.Table(blogpost.Table))
+	withSearchHighlights("go")(s)
+	query, _ := s.Query()
+
+	// Rows without content_html are highlighted in their content
+	want := `ts_headline($3::regconfig, COALESCE(NULLIF("blog_posts"."content_html", ''), "blog_posts"."content"), to_tsquery($4::regconfig, $5), $6)`
+	if !strings.Contains(query, want) {
+		t.Errorf("query = %s\nwant it to contain %s", query, want)
+	}
+}
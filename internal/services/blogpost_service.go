@@ -2,15 +2,34 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
 	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/category"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/schema"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/ent/tag"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
 	"github.com/AdongoJr2/technoprise-backend/internal/utils"
 	"log"
 	"math"
+	"strings"
 	"time"
 )
 
+// ErrNotFound is wrapped by service errors when the requested record does not exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrInvalidInput is wrapped by service errors caused by invalid client input.
+var ErrInvalidInput = errors.New("invalid input")
+
+// ErrConflict is wrapped by service errors when a change conflicts with other records.
+var ErrConflict = errors.New("conflict")
+
 // BlogPostService provides business logic for blog posts.
 type BlogPostService struct {
 	client       *ent.Client
@@ -27,25 +46,144 @@ func NewBlogPostService(client *ent.Client, imageService *ImageService) *BlogPos
 
 // CreateBlogPostInput defines the input structure for creating a blog post.
 type CreateBlogPostInput struct {
-	Title       string  `json:"title"`
-	Excerpt     string  `json:"excerpt"`
-	Content     string  `json:"content"`
-	Image       *string `json:"image,omitempty"`
+	Title string `json:"title"`
+	// Excerpt is derived from the content when empty.
+	Excerpt string `json:"excerpt"`
+	Content string `json:"content"`
+	// ContentFormat is markdown, html or plaintext; markdown when nil.
+	ContentFormat *string `json:"content_format,omitempty"`
+	Image         *string `json:"image,omitempty"`
+	// ImageVariants are the resized renditions of Image.
+	ImageVariants []schematype.ImageVariant `json:"-"`
+	// MediaID references the Media entity recording Image.
+	MediaID     *int    `json:"-"`
 	PublishedAt *string `json:"published_at,omitempty"`
 	Slug        *string `json:"slug,omitempty"`
+	Status      *string `json:"status,omitempty"`
+	// AuthorSlug sets the byline. When nil, the creating user's author profile is used.
+	AuthorSlug   *string  `json:"author,omitempty"`
+	AuthorID     *int     `json:"-"`
+	CategorySlug *string  `json:"category,omitempty"`
+	TagSlugs     []string `json:"tags,omitempty"`
 }
 
 // UpdateBlogPostInput defines the input structure for updating a blog post.
+// Nil fields are left untouched.
 type UpdateBlogPostInput struct {
-	Title   *string `json:"title,omitempty"`
-	Excerpt *string `json:"excerpt,omitempty"`
-	Content *string `json:"content,omitempty"`
+	Title *string `json:"title,omitempty"`
+	// Excerpt replaces the excerpt; an empty value derives it from the content.
+	Excerpt       *string `json:"excerpt,omitempty"`
+	Content       *string `json:"content,omitempty"`
+	ContentFormat *string `json:"content_format,omitempty"`
+	Image         *string `json:"image,omitempty"`
+	// ImageVariants are the resized renditions of a new Image.
+	ImageVariants []schematype.ImageVariant `json:"-"`
+	// MediaID references the Media entity recording a new Image.
+	MediaID        *int    `json:"-"`
+	RemoveImage    bool    `json:"remove_image,omitempty"`
+	PublishedAt    *string `json:"published_at,omitempty"`
+	Slug           *string `json:"slug,omitempty"`
+	RegenerateSlug bool    `json:"regenerate_slug,omitempty"`
+	// AuthorSlug changes the byline; an empty value removes it.
+	AuthorSlug *string `json:"author,omitempty"`
+	// CategorySlug changes the category; an empty value removes it.
+	CategorySlug *string `json:"category,omitempty"`
+	// TagSlugs replaces the post's tags when non-nil; an empty slice removes them all.
+	TagSlugs []string `json:"tags,omitempty"`
+	// EditorID is the user making the change, recorded with the revision.
+	EditorID *int `json:"-"`
+
+	// restoredFrom is the number of the revision being restored, if any.
+	restoredFrom *int
+}
+
+// ListBlogPostsParams defines the filters and pagination for listing blog posts.
+type ListBlogPostsParams struct {
+	Page   int
+	Limit  int
+	Search string
+	// After and Before switch to cursor (keyset) pagination, returning the
+	// page following or preceding the given cursor. Page is then ignored.
+	After  string
+	Before string
+	// Status restricts the listing to a single status. Only honoured when
+	// IncludeUnpublished is set.
+	Status string
+	// IncludeUnpublished lists posts regardless of status and publish date,
+	// for editors. Public listings leave it false.
+	IncludeUnpublished bool
+	// Trashed lists the posts in the trash instead of the others. Only honoured
+	// when IncludeUnpublished is set.
+	Trashed bool
+	// AuthorID, when non-zero, restricts the listing to one user's posts.
+	AuthorID int
+	// AuthorSlug, when set, restricts the listing to posts bylined to that author profile.
+	AuthorSlug string
+	// CategorySlug, when set, restricts the listing to posts in that category or its subcategories.
+	CategorySlug string
+	// TagSlugs, when set, restricts the listing to posts carrying any of these tags.
+	TagSlugs []string
+	// PublishedFrom, PublishedTo, CreatedFrom and CreatedTo bound the
+	// respective dates, inclusively.
+	PublishedFrom *time.Time
+	PublishedTo   *time.Time
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	// HasImage, when set, restricts the listing to posts with or without an image.
+	HasImage *bool
+	// Sort lists sort keys such as "published_at" or "-title"; "-" sorts descending.
+	// Defaults to newest first, or relevance when searching.
+	Sort []string
+	// Fields is a sparse fieldset limiting the returned fields and edges.
+	Fields []string
+}
+
+// BlogPostView is a blog post as returned by the API, with its image and
+// variants combined into an ImageSet.
+type BlogPostView struct {
+	*ent.BlogPost
+	// Content and ContentHTML shadow the embedded fields, so that only the form
+	// of the content the client asked for is returned: raw by default.
+	Content     string   `json:"content,omitempty"`
+	ContentHTML string   `json:"content_html,omitempty"`
+	Image       ImageSet `json:"image,omitempty"`
+	// ImageVariants hides the raw variants, which are part of Image. A nil
+	// pointer is always omitted and shadows the embedded field of the same name.
+	ImageVariants *struct{} `json:"image_variants,omitempty"`
+}
+
+// NewBlogPostView wraps post for an API response.
+func NewBlogPostView(post *ent.BlogPost) *BlogPostView {
+	return &BlogPostView{
+		BlogPost: post,
+		Content:  post.Content,
+		Image:    NewImageSet(post.Image, post.ImageVariants),
+	}
+}
+
+// RenderContent replaces the raw content of v with its sanitized HTML rendering.
+func (v *BlogPostView) RenderContent() {
+	v.Content, v.ContentHTML = "", v.BlogPost.ContentHTML
+}
+
+// BlogPostListItem is a blog post as returned in listings.
+type BlogPostListItem struct {
+	*BlogPostView
+	// Headline is a content snippet with matches wrapped in <mark>, set for search results.
+	Headline string `json:"headline,omitempty"`
+	// Rank is the search relevance score, set for search results.
+	Rank float64 `json:"rank,omitempty"`
+
+	// keys restricts the JSON output when a sparse fieldset was requested.
+	keys map[string]bool
 }
 
 // PaginatedBlogPosts holds blog posts and pagination metadata.
+// Pagination is nil in cursor mode, where no total count is computed.
 type PaginatedBlogPosts struct {
-	Data       []*ent.BlogPost `json:"data"`
-	Pagination PaginationMeta  `json:"pagination"`
+	Data       []*BlogPostListItem `json:"data"`
+	Pagination *PaginationMeta     `json:"pagination,omitempty"`
+	Cursors    CursorMeta          `json:"cursors"`
 }
 
 // PaginationMeta holds pagination details.
@@ -56,57 +194,133 @@ type PaginationMeta struct {
 	TotalPages int `json:"totalPages"`
 }
 
-// CreateBlogPost creates a new blog post in the database.
+// CreateBlogPost creates a new blog post in the database, along with its first revision.
 func (s *BlogPostService) CreateBlogPost(ctx context.Context, input CreateBlogPostInput) (*ent.BlogPost, error) {
-	slug := ""
+	return retrySlugConflict(func() (*ent.BlogPost, error) {
+		return s.createBlogPost(ctx, input)
+	})
+}
+
+// createBlogPost makes a single attempt at CreateBlogPost.
+func (s *BlogPostService) createBlogPost(ctx context.Context, input CreateBlogPostInput) (*ent.BlogPost, error) {
+	var slug string
 	if input.Slug != nil && *input.Slug != "" {
 		slug = utils.GenerateSlug(*input.Slug)
 	} else {
 		slug = utils.GenerateSlug(input.Title)
 	}
-
-	existing, err := s.client.BlogPost.Query().Where(blogpost.SlugEQ(slug)).Only(ctx)
-	if err != nil && !ent.IsNotFound(err) {
-		log.Printf("Error checking for existing slug: %v", err)
-		return nil, fmt.Errorf("failed to check for existing slug: %w", err)
+	if slug == "" {
+		slug = fallbackSlug
 	}
-	if existing != nil {
-		slug = fmt.Sprintf("%s-%d", slug, time.Now().Unix())
+
+	slug, err := s.uniqueSlug(ctx, slug, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	var publishedAt time.Time
+	var publishedAt *time.Time
 	if input.PublishedAt != nil {
-		parsedTime, err := time.Parse(time.RFC3339, *input.PublishedAt)
+		parsedTime, err := parsePublishedAt(*input.PublishedAt)
 		if err != nil {
-			return nil, fmt.Errorf("invalid published_at format: %w. Use format %s", err, time.RFC3339)
+			return nil, err
 		}
-		publishedAt = parsedTime
-	} else {
-		publishedAt = time.Now()
+		publishedAt = &parsedTime
+	}
+
+	status, publishedAt, err := initialStatus(input.Status, publishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	format := blogpost.ContentFormatMarkdown
+	if input.ContentFormat != nil && *input.ContentFormat != "" {
+		if format, err = parseContentFormat(*input.ContentFormat); err != nil {
+			return nil, err
+		}
+	}
+	rendered, err := renderContent(input.Content, format)
+	if err != nil {
+		return nil, err
+	}
+
+	excerpt, excerptGenerated := input.Excerpt, false
+	if strings.TrimSpace(excerpt) == "" {
+		if excerpt, err = deriveExcerpt(rendered.Text, input.Title); err != nil {
+			return nil, err
+		}
+		excerptGenerated = true
+	} else if err := validateExcerpt(excerpt); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
+	defer tx.Rollback() // no-op once committed
 
-	postCreate := s.client.BlogPost.
+	postCreate := tx.BlogPost.
 		Create().
 		SetTitle(input.Title).
 		SetSlug(slug).
 		SetContent(input.Content).
-		SetExcerpt(input.Excerpt).
-		SetPublishedAt(publishedAt)
+		SetContentFormat(format).
+		SetContentHTML(rendered.HTML).
+		SetToc(rendered.TOC).
+		SetWordCount(rendered.WordCount).
+		SetReadingTime(rendered.ReadingTime).
+		SetExcerpt(excerpt).
+		SetExcerptGenerated(excerptGenerated).
+		SetStatus(status).
+		SetNillablePublishedAt(publishedAt).
+		SetNillableAuthorID(input.AuthorID)
+
+	bylineID, err := s.resolveByline(ctx, input.AuthorSlug, input.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	postCreate = postCreate.SetNillableBylineID(bylineID)
+
+	if input.CategorySlug != nil && *input.CategorySlug != "" {
+		categoryID, err := s.resolveCategory(ctx, *input.CategorySlug)
+		if err != nil {
+			return nil, err
+		}
+		postCreate = postCreate.SetCategoryID(categoryID)
+	}
+
+	if len(input.TagSlugs) > 0 {
+		tagIDs, err := s.resolveTags(ctx, input.TagSlugs)
+		if err != nil {
+			return nil, err
+		}
+		postCreate = postCreate.AddTagIDs(tagIDs...)
+	}
 
 	if input.Image != nil {
-		postCreate = postCreate.SetImage(*input.Image)
+		postCreate = postCreate.SetImage(*input.Image).SetImageVariants(input.ImageVariants).SetNillableMediaID(input.MediaID)
 	}
 
 	post, err := postCreate.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create blog post: %w", err)
 	}
+	if err := recordRevision(ctx, tx, nil, post, input.AuthorID, nil); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit blog post: %w", err)
+	}
+	post = post.Unwrap()
 
+	s.imageService.resolvePosts(ctx, post)
 	return post, nil
 }
 
 // GetBlogPosts retrieves a list of blog posts with pagination and search.
-func (s *BlogPostService) GetBlogPosts(ctx context.Context, page, limit int, searchTerm string) (*PaginatedBlogPosts, error) {
+// Unless params.IncludeUnpublished is set, only published posts are returned.
+func (s *BlogPostService) GetBlogPosts(ctx context.Context, params ListBlogPostsParams) (*PaginatedBlogPosts, error) {
+	page, limit, searchTerm := params.Page, params.Limit, params.Search
 	if page < 1 {
 		page = 1
 	}
@@ -116,62 +330,241 @@ func (s *BlogPostService) GetBlogPosts(ctx context.Context, page, limit int, sea
 
 	query := s.client.BlogPost.Query()
 
-	// Apply search filter if searchTerm is provided
-	if searchTerm != "" {
-		query = query.Where(
-			blogpost.Or(
-				blogpost.TitleContainsFold(searchTerm),
-				blogpost.ContentContainsFold(searchTerm),
-				blogpost.ExcerptContainsFold(searchTerm),
-			),
-		)
+	if params.IncludeUnpublished && params.Trashed {
+		ctx = schema.SkipSoftDelete(ctx)
+		query = query.Where(blogpost.DeletedAtNotNil())
+	}
+	if !params.IncludeUnpublished {
+		query = query.Where(isPubliclyVisible())
+	} else if params.Status != "" {
+		status := blogpost.Status(params.Status)
+		if err := blogpost.StatusValidator(status); err != nil {
+			return nil, invalidParam("status", "unknown status '%s'", params.Status)
+		}
+		query = query.Where(blogpost.StatusEQ(status))
+	}
+
+	if params.AuthorID != 0 {
+		query = query.Where(blogpost.HasAuthorWith(user.IDEQ(params.AuthorID)))
+	}
+	if params.AuthorSlug != "" {
+		query = query.Where(blogpost.HasBylineWith(author.SlugEQ(params.AuthorSlug)))
+	}
+
+	if params.CategorySlug != "" {
+		categoryID, err := s.client.Category.Query().Where(category.SlugEQ(params.CategorySlug)).OnlyID(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, invalidParam("category", "category with slug '%s' not found", params.CategorySlug)
+			}
+			return nil, fmt.Errorf("failed to retrieve category: %w", err)
+		}
+		categoryIDs, err := categoryTreeIDs(ctx, s.client, categoryID)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(blogpost.HasCategoryWith(category.IDIn(categoryIDs...)))
+	}
+
+	if len(params.TagSlugs) > 0 {
+		query = query.Where(blogpost.HasTagsWith(tag.SlugIn(params.TagSlugs...)))
+	}
+
+	if params.PublishedFrom != nil {
+		query = query.Where(blogpost.PublishedAtGTE(*params.PublishedFrom))
+	}
+	if params.PublishedTo != nil {
+		query = query.Where(blogpost.PublishedAtLTE(*params.PublishedTo))
+	}
+	if params.CreatedFrom != nil {
+		query = query.Where(blogpost.CreateTimeGTE(*params.CreatedFrom))
+	}
+	if params.CreatedTo != nil {
+		query = query.Where(blogpost.CreateTimeLTE(*params.CreatedTo))
+	}
+
+	if params.HasImage != nil {
+		hasImage := blogpost.And(blogpost.ImageNotNil(), blogpost.ImageNEQ(""))
+		if *params.HasImage {
+			query = query.Where(hasImage)
+		} else {
+			query = query.Where(blogpost.Not(hasImage))
+		}
 	}
 
-	// Get total count for pagination
-	total, err := query.Count(ctx)
+	orders, err := parseSort(params.Sort)
 	if err != nil {
-		log.Printf("Error counting blog posts: %v", err)
-		return nil, fmt.Errorf("failed to count blog posts: %w", err)
+		return nil, err
 	}
+	defaultSort := isDefaultSort(params.Sort)
 
-	// Calculate pagination details
-	offset := (page - 1) * limit
-	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+	selection, err := resolveFields(params.Fields)
+	if err != nil {
+		return nil, err
+	}
 
-	// Fetch posts with pagination, ordered by creation date descending
-	posts, err := query.
-		Select(
-			blogpost.FieldID,
-			blogpost.FieldTitle,
-			blogpost.FieldSlug,
-			blogpost.FieldExcerpt,
-			blogpost.FieldCreateTime,
-			blogpost.FieldUpdateTime,
-			blogpost.FieldPublishedAt,
-			blogpost.FieldImage,
-		).
-		Order(ent.Desc(blogpost.FieldCreateTime)).
-		Offset(offset).
-		Limit(limit).
-		All(ctx)
+	// Apply full-text search filter if searchTerm is provided
+	tsQuery := buildTSQuery(searchTerm)
+	if tsQuery != "" {
+		query = query.Where(matchesSearch(tsQuery))
+	}
+
+	var (
+		cursor     *postCursor
+		backward   bool
+		pagination *PaginationMeta
+	)
+	// Cursors are only defined for the default order
+	withCursors := tsQuery == "" && defaultSort
+
+	if params.After != "" || params.Before != "" {
+		if params.After != "" && params.Before != "" {
+			return nil, invalidParam("before", "after and before cannot be combined")
+		}
+		if !withCursors {
+			return nil, invalidParam(cursorParam(params), "cursor pagination cannot be combined with search or a custom sort")
+		}
+
+		if params.After != "" {
+			cursor, err = decodeCursor("after", params.After)
+		} else {
+			cursor, err = decodeCursor("before", params.Before)
+			backward = true
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if backward {
+			query = query.Where(beforeCursor(cursor))
+		} else {
+			query = query.Where(afterCursor(cursor))
+		}
+	} else {
+		// Get total count for pagination
+		total, err := query.Count(ctx)
+		if err != nil {
+			log.Printf("Error counting blog posts: %v", err)
+			return nil, fmt.Errorf("failed to count blog posts: %w", err)
+		}
+
+		pagination = &PaginationMeta{
+			Total:      total,
+			Page:       page,
+			Limit:      limit,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		}
+		query = query.Offset((page - 1) * limit)
+	}
+
+	if withCursors {
+		selection.withColumn(blogpost.FieldCreateTime)
+	}
+
+	// Fetch one extra row to tell whether another page follows
+	query = query.
+		Select(selection.columns...).
+		Limit(limit + 1)
+	if selection.edges[bylineEdge] {
+		query = query.WithByline(withBylineSummary)
+	}
+	if selection.edges[categoryEdge] {
+		query = query.WithCategory(withCategorySummary)
+	}
+	if selection.edges[tagsEdge] {
+		query = query.WithTags(withTagSummary)
+	}
+	if selection.edges[mediaEdge] {
+		query = query.WithMedia(withMediaSummary)
+	}
+
+	// An explicit sort wins; otherwise search hits are ordered by relevance and
+	// everything else by creation date descending. Paging backward walks the
+	// default order in reverse.
+	switch {
+	case orders != nil:
+		query = query.Order(orders...)
+	case tsQuery != "":
+		query = query.Order(byRelevance(tsQuery), ent.Desc(blogpost.FieldCreateTime), ent.Desc(blogpost.FieldID))
+	case backward:
+		query = query.Order(ent.Asc(blogpost.FieldCreateTime), ent.Asc(blogpost.FieldID))
+	default:
+		query = query.Order(ent.Desc(blogpost.FieldCreateTime), ent.Desc(blogpost.FieldID))
+	}
+
+	var posts []*ent.BlogPost
+	if tsQuery != "" {
+		posts, err = query.Modify(withSearchHighlights(tsQuery)).All(ctx)
+	} else {
+		posts, err = query.All(ctx)
+	}
 	if err != nil {
 		log.Printf("Error fetching paginated blog posts: %v", err)
 		return nil, fmt.Errorf("failed to fetch blog posts: %w", err)
 	}
 
+	hasMore := len(posts) > limit
+	if hasMore {
+		posts = posts[:limit]
+	}
+	if backward {
+		for i, j := 0, len(posts)-1; i < j; i, j = i+1, j-1 {
+			posts[i], posts[j] = posts[j], posts[i]
+		}
+	}
+
+	var cursors CursorMeta
+	if len(posts) > 0 && withCursors {
+		first, last := posts[0], posts[len(posts)-1]
+		switch {
+		case backward:
+			cursors.Next = encodeCursor(last)
+			if hasMore {
+				cursors.Prev = encodeCursor(first)
+			}
+		case cursor != nil:
+			cursors.Prev = encodeCursor(first)
+			if hasMore {
+				cursors.Next = encodeCursor(last)
+			}
+		default:
+			if hasMore {
+				cursors.Next = encodeCursor(last)
+			}
+			if page > 1 {
+				cursors.Prev = encodeCursor(first)
+			}
+		}
+	}
+
+	s.imageService.resolvePosts(ctx, posts...)
+	items := make([]*BlogPostListItem, len(posts))
+	for i, post := range posts {
+		items[i] = &BlogPostListItem{BlogPostView: NewBlogPostView(post), keys: selection.keys}
+		if tsQuery != "" {
+			items[i].Headline, items[i].Rank = searchHighlights(post)
+		}
+	}
+
 	return &PaginatedBlogPosts{
-		Data: posts,
-		Pagination: PaginationMeta{
-			Total:      total,
-			Page:       page,
-			Limit:      limit,
-			TotalPages: totalPages,
-		},
+		Data:       items,
+		Pagination: pagination,
+		Cursors:    cursors,
 	}, nil
 }
 
-// GetBlogPostBySlug retrieves a single blog post by its slug.
+// GetBlogPostBySlug retrieves a single published blog post by its slug. A
+// former slug of the post yields a SlugMovedError naming the current one.
 func (s *BlogPostService) GetBlogPostBySlug(ctx context.Context, slug string) (*ent.BlogPost, error) {
+	return s.getBlogPostBySlug(ctx, slug, isPubliclyVisible())
+}
+
+// PreviewBlogPost retrieves a single blog post by its slug regardless of its status.
+func (s *BlogPostService) PreviewBlogPost(ctx context.Context, slug string) (*ent.BlogPost, error) {
+	return s.getBlogPostBySlug(ctx, slug)
+}
+
+func (s *BlogPostService) getBlogPostBySlug(ctx context.Context, slug string, ps ...predicate.BlogPost) (*ent.BlogPost, error) {
 	post, err := s.client.BlogPost.
 		Query().
 		Select(
@@ -179,19 +572,393 @@ func (s *BlogPostService) GetBlogPostBySlug(ctx context.Context, slug string) (*
 			blogpost.FieldTitle,
 			blogpost.FieldSlug,
 			blogpost.FieldContent,
+			blogpost.FieldContentFormat,
+			blogpost.FieldContentHTML,
+			blogpost.FieldToc,
+			blogpost.FieldWordCount,
+			blogpost.FieldReadingTime,
 			blogpost.FieldExcerpt,
 			blogpost.FieldCreateTime,
 			blogpost.FieldUpdateTime,
 			blogpost.FieldPublishedAt,
 			blogpost.FieldImage,
-		).Where(blogpost.SlugEQ(slug)).
+			blogpost.FieldImageVariants,
+			blogpost.FieldStatus,
+		).Where(append(ps, blogpost.SlugEQ(slug))...).
+		WithByline(withBylineSummary).
+		WithCategory(withCategorySummary).
+		WithTags(withTagSummary).
+		WithMedia(withMediaSummary).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("blog post with slug '%s' not found", slug)
+			if err := s.movedPost(ctx, slug, ps...); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("blog post with slug '%s' %w", slug, ErrNotFound)
+		}
+		log.Printf("Error fetching blog post by slug '%s': %v", slug, err)
+		return nil, fmt.Errorf("failed to retrieve blog post: %w", err)
+	}
+	if err := ensureRendered(post); err != nil {
+		return nil, err
+	}
+	s.imageService.resolvePosts(ctx, post)
+	return post, nil
+}
+
+// UpdateBlogPost applies a partial update to the blog post identified by slug,
+// recording a revision when its title, excerpt or content change. A replaced
+// slug is kept as an alias that redirects to the new one.
+// A replaced or removed image stays in the media library; images that predate
+// the library are deleted from storage once the update succeeds.
+func (s *BlogPostService) UpdateBlogPost(ctx context.Context, slug string, input UpdateBlogPostInput) (*ent.BlogPost, error) {
+	return retrySlugConflict(func() (*ent.BlogPost, error) {
+		return s.updateBlogPost(ctx, slug, input)
+	})
+}
+
+// updateBlogPost makes a single attempt at UpdateBlogPost.
+func (s *BlogPostService) updateBlogPost(ctx context.Context, slug string, input UpdateBlogPostInput) (*ent.BlogPost, error) {
+	post, err := s.findBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback() // no-op once committed
+
+	postUpdate := tx.BlogPost.UpdateOne(post)
+
+	if input.Title != nil {
+		postUpdate = postUpdate.SetTitle(*input.Title)
+	}
+	var rendered *renderedContent
+	if input.Content != nil || input.ContentFormat != nil {
+		content, format := post.Content, post.ContentFormat
+		if input.Content != nil {
+			content = *input.Content
+		}
+		if input.ContentFormat != nil {
+			if format, err = parseContentFormat(*input.ContentFormat); err != nil {
+				return nil, err
+			}
+		}
+		if rendered, err = renderContent(content, format); err != nil {
+			return nil, err
+		}
+		postUpdate = postUpdate.
+			SetContent(content).
+			SetContentFormat(format).
+			SetContentHTML(rendered.HTML).
+			SetToc(rendered.TOC).
+			SetWordCount(rendered.WordCount).
+			SetReadingTime(rendered.ReadingTime)
+	}
+
+	// A written excerpt is kept; a derived one follows the content
+	switch {
+	case input.Excerpt != nil && strings.TrimSpace(*input.Excerpt) != "":
+		if err := validateExcerpt(*input.Excerpt); err != nil {
+			return nil, err
+		}
+		postUpdate = postUpdate.SetExcerpt(*input.Excerpt).SetExcerptGenerated(false)
+	case input.Excerpt != nil || (rendered != nil && post.ExcerptGenerated):
+		if rendered == nil {
+			if rendered, err = renderContent(post.Content, post.ContentFormat); err != nil {
+				return nil, err
+			}
+		}
+		title := post.Title
+		if input.Title != nil {
+			title = *input.Title
+		}
+		excerpt, err := deriveExcerpt(rendered.Text, title)
+		if err != nil {
+			return nil, err
+		}
+		postUpdate = postUpdate.SetExcerpt(excerpt).SetExcerptGenerated(true)
+	}
+
+	newSlug := ""
+	if input.Slug != nil && *input.Slug != "" {
+		newSlug = utils.GenerateSlug(*input.Slug)
+	} else if input.RegenerateSlug {
+		title := post.Title
+		if input.Title != nil {
+			title = *input.Title
+		}
+		newSlug = utils.GenerateSlug(title)
+	}
+	if newSlug != "" && newSlug != post.Slug {
+		newSlug, err = s.uniqueSlug(ctx, newSlug, post.ID)
+		if err != nil {
+			return nil, err
+		}
+		postUpdate = postUpdate.SetSlug(newSlug)
+	}
+
+	if input.PublishedAt != nil {
+		var publishedAt *time.Time
+		if *input.PublishedAt != "" {
+			parsedTime, err := parsePublishedAt(*input.PublishedAt)
+			if err != nil {
+				return nil, err
+			}
+			publishedAt = &parsedTime
+		}
+		if err := checkPublishedAt(post.Status, publishedAt); err != nil {
+			return nil, err
+		}
+		if publishedAt == nil {
+			postUpdate = postUpdate.ClearPublishedAt()
+		} else {
+			postUpdate = postUpdate.SetPublishedAt(*publishedAt)
+		}
+	}
+
+	if input.AuthorSlug != nil {
+		if *input.AuthorSlug == "" {
+			postUpdate = postUpdate.ClearByline()
+		} else {
+			bylineID, err := s.resolveByline(ctx, input.AuthorSlug, nil)
+			if err != nil {
+				return nil, err
+			}
+			postUpdate = postUpdate.SetNillableBylineID(bylineID)
+		}
+	}
+
+	if input.CategorySlug != nil {
+		if *input.CategorySlug == "" {
+			postUpdate = postUpdate.ClearCategory()
+		} else {
+			categoryID, err := s.resolveCategory(ctx, *input.CategorySlug)
+			if err != nil {
+				return nil, err
+			}
+			postUpdate = postUpdate.SetCategoryID(categoryID)
+		}
+	}
+
+	if input.TagSlugs != nil {
+		tagIDs, err := s.resolveTags(ctx, input.TagSlugs)
+		if err != nil {
+			return nil, err
+		}
+		postUpdate = postUpdate.ClearTags().AddTagIDs(tagIDs...)
+	}
+
+	var stale *staleUpload
+	if input.Image != nil || input.RemoveImage {
+		if input.Image != nil {
+			postUpdate = postUpdate.SetImage(*input.Image).SetImageVariants(input.ImageVariants).ClearMedia().SetNillableMediaID(input.MediaID)
+		} else {
+			postUpdate = postUpdate.ClearImage().ClearImageVariants().ClearMedia()
+		}
+		if stale, err = s.staleUpload(ctx, post); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := postUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update blog post: %w", err)
+	}
+	if updated.Slug != post.Slug {
+		if err := recordSlugChange(ctx, tx, post, updated.Slug); err != nil {
+			return nil, err
+		}
+	}
+	if input.restoredFrom != nil || revisionChanged(post, updated) {
+		if err := recordRevision(ctx, tx, post, updated, input.EditorID, input.restoredFrom); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit blog post update: %w", err)
+	}
+	updated = updated.Unwrap()
+
+	s.releaseUpload(ctx, stale, updated.Image)
+
+	s.imageService.resolvePosts(ctx, updated)
+	return updated, nil
+}
+
+// DeleteBlogPost moves the blog post identified by slug to the trash, from
+// which it can be restored until PurgeTrash deletes it for good.
+func (s *BlogPostService) DeleteBlogPost(ctx context.Context, slug string) error {
+	post, err := s.findBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.BlogPost.UpdateOne(post).SetDeletedAt(time.Now()).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete blog post: %w", err)
+	}
+	return nil
+}
+
+// AuthorizePostAccess checks whether actor may manage the post identified by slug.
+// Admins and editors may manage any post; authors only their own.
+func (s *BlogPostService) AuthorizePostAccess(ctx context.Context, slug string, actor *ent.User) error {
+	if actor == nil {
+		return ErrForbidden
+	}
+
+	switch actor.Role {
+	case user.RoleAdmin, user.RoleEditor:
+		return nil
+	case user.RoleAuthor:
+		// Trashed posts too, so that their authors may restore them
+		post, err := s.findBySlug(schema.SkipSoftDelete(ctx), slug)
+		if err != nil {
+			return err
+		}
+		authorID, err := post.QueryAuthor().OnlyID(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to retrieve blog post author: %w", err)
+		}
+		if authorID == actor.ID {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: you may only manage your own blog posts", ErrForbidden)
+}
+
+// resolveByline returns the author profile ID for authorSlug, falling back to
+// the profile linked to userID when no slug is given.
+func (s *BlogPostService) resolveByline(ctx context.Context, authorSlug *string, userID *int) (*int, error) {
+	if authorSlug != nil && *authorSlug != "" {
+		id, err := s.client.Author.Query().Where(author.SlugEQ(*authorSlug)).OnlyID(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: author with slug '%s' not found", ErrInvalidInput, *authorSlug)
+			}
+			return nil, fmt.Errorf("failed to retrieve author: %w", err)
+		}
+		return &id, nil
+	}
+
+	if userID == nil {
+		return nil, nil
+	}
+	id, err := s.client.Author.Query().Where(author.HasUserWith(user.IDEQ(*userID))).OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve author profile: %w", err)
+	}
+	return &id, nil
+}
+
+// resolveCategory returns the ID of the category with the given slug.
+func (s *BlogPostService) resolveCategory(ctx context.Context, slug string) (int, error) {
+	id, err := s.client.Category.Query().Where(category.SlugEQ(slug)).OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, fmt.Errorf("%w: category with slug '%s' not found", ErrInvalidInput, slug)
+		}
+		return 0, fmt.Errorf("failed to retrieve category: %w", err)
+	}
+	return id, nil
+}
+
+// resolveTags returns the IDs of the tags with the given slugs, failing if any is unknown.
+func (s *BlogPostService) resolveTags(ctx context.Context, slugs []string) ([]int, error) {
+	if len(slugs) == 0 {
+		return nil, nil
+	}
+
+	tags, err := s.client.Tag.Query().Where(tag.SlugIn(slugs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tags: %w", err)
+	}
+
+	found := make(map[string]int, len(tags))
+	for _, t := range tags {
+		found[t.Slug] = t.ID
+	}
+
+	ids := make([]int, 0, len(tags))
+	seen := make(map[string]bool, len(slugs))
+	for _, slug := range slugs {
+		if seen[slug] {
+			continue // ignore duplicates
+		}
+		seen[slug] = true
+		id, ok := found[slug]
+		if !ok {
+			return nil, fmt.Errorf("%w: tag with slug '%s' not found", ErrInvalidInput, slug)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// findBySlug loads the full blog post for the given slug.
+func (s *BlogPostService) findBySlug(ctx context.Context, slug string) (*ent.BlogPost, error) {
+	post, err := s.client.BlogPost.Query().Where(blogpost.SlugEQ(slug)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("blog post with slug '%s' %w", slug, ErrNotFound)
 		}
 		log.Printf("Error fetching blog post by slug '%s': %v", slug, err)
 		return nil, fmt.Errorf("failed to retrieve blog post: %w", err)
 	}
 	return post, nil
 }
+
+// removeImage deletes a stored image and its variants, logging rather than
+// failing on error since the database change has already been committed.
+func (s *BlogPostService) removeImage(ctx context.Context, image string, variants []schematype.ImageVariant) {
+	if err := s.imageService.DeleteResponsiveImage(ctx, image, variants); err != nil {
+		log.Printf("Error deleting image '%s': %v", image, err)
+	}
+}
+
+// staleUpload is the upload behind a post's image, to release once the post
+// no longer uses it.
+type staleUpload struct {
+	// image and variants are set for an image that predates the media library.
+	image    string
+	variants []schematype.ImageVariant
+	// mediaID is set for an entry created by uploading the post's image.
+	mediaID int
+}
+
+// staleUpload returns the upload to release when post stops using its image,
+// or nil when its image is a media library entry chosen for it.
+func (s *BlogPostService) staleUpload(ctx context.Context, post *ent.BlogPost) (*staleUpload, error) {
+	m, err := post.QueryMedia().Select(media.FieldPostUpload).Only(schema.SkipSoftDelete(ctx))
+	switch {
+	case ent.IsNotFound(err):
+		if post.Image == "" {
+			return nil, nil
+		}
+		return &staleUpload{image: post.Image, variants: post.ImageVariants}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to retrieve blog post media: %w", err)
+	case m.PostUpload:
+		return &staleUpload{mediaID: m.ID}, nil
+	}
+	return nil, nil
+}
+
+// releaseUpload deletes the stale upload unless current, the image the post
+// now has, is the same one. Entries created for the post are only deleted
+// when nothing else uses them.
+func (s *BlogPostService) releaseUpload(ctx context.Context, stale *staleUpload, current string) {
+	switch {
+	case stale == nil:
+	case stale.mediaID != 0:
+		s.imageService.releasePostUpload(ctx, stale.mediaID)
+	case stale.image != current:
+		s.removeImage(ctx, stale.image, stale.variants)
+	}
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/schema"
+	"github.com/AdongoJr2/technoprise-backend/ent/slugalias"
+)
+
+// ErrMoved is wrapped by errors reporting that a record was requested by a
+// former identifier.
+var ErrMoved = errors.New("moved")
+
+// fallbackSlug is used for a post whose title yields no slug, such as one
+// made only of emoji.
+const fallbackSlug = "post"
+
+// SlugMovedError reports that a blog post was requested by a former slug.
+// It wraps ErrMoved.
+type SlugMovedError struct {
+	// OldSlug is the slug requested; Slug is the post's current slug.
+	OldSlug string
+	Slug    string
+}
+
+func (e *SlugMovedError) Error() string {
+	return fmt.Sprintf("blog post '%s' has moved to '%s'", e.OldSlug, e.Slug)
+}
+
+func (e *SlugMovedError) Unwrap() error {
+	return ErrMoved
+}
+
+// movedPost returns a SlugMovedError if slug is a former slug of a post
+// matching ps, or nil if it is not.
+func (s *BlogPostService) movedPost(ctx context.Context, slug string, ps ...predicate.BlogPost) error {
+	current, err := s.client.SlugAlias.Query().
+		Where(slugalias.SlugEQ(slug)).
+		QueryPost().
+		Where(ps...).
+		Select(blogpost.FieldSlug).
+		String(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve slug alias: %w", err)
+	}
+	return &SlugMovedError{OldSlug: slug, Slug: current}
+}
+
+// recordSlugChange keeps the slug post is leaving as an alias of it, within
+// tx, and drops any alias the new slug reclaims.
+func recordSlugChange(ctx context.Context, tx *ent.Tx, post *ent.BlogPost, newSlug string) error {
+	_, err := tx.SlugAlias.Delete().
+		Where(slugalias.SlugEQ(newSlug), slugalias.HasPostWith(blogpost.ID(post.ID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove slug alias: %w", err)
+	}
+
+	err = tx.SlugAlias.Create().
+		SetSlug(post.Slug).
+		SetPostID(post.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record slug alias: %w", err)
+	}
+	return nil
+}
+
+// uniqueSlug returns base, or base with the lowest free suffix from -2 up if
+// another post (other than excludeID) already uses it, including posts in the
+// trash, or used it before.
+func (s *BlogPostService) uniqueSlug(ctx context.Context, base string, excludeID int) (string, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+	taken, err := s.client.BlogPost.Query().
+		Where(slugFamily[predicate.BlogPost](base), blogpost.IDNEQ(excludeID)).
+		Select(blogpost.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		log.Printf("Error checking for existing slug: %v", err)
+		return "", fmt.Errorf("failed to check for existing slug: %w", err)
+	}
+
+	// A post may take back its own former slugs
+	aliases, err := s.client.SlugAlias.Query().
+		Where(
+			slugFamily[predicate.SlugAlias](base),
+			slugalias.Not(slugalias.HasPostWith(blogpost.ID(excludeID))),
+		).
+		Select(slugalias.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for existing slug alias: %w", err)
+	}
+	return nextFreeSlug(base, append(taken, aliases...)), nil
+}
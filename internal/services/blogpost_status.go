@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+)
+
+// statusTransitions lists the statuses each status may move to.
+var statusTransitions = map[blogpost.Status][]blogpost.Status{
+	blogpost.StatusDraft:     {blogpost.StatusScheduled, blogpost.StatusPublished, blogpost.StatusArchived},
+	blogpost.StatusScheduled: {blogpost.StatusDraft, blogpost.StatusScheduled, blogpost.StatusPublished, blogpost.StatusArchived},
+	blogpost.StatusPublished: {blogpost.StatusDraft, blogpost.StatusArchived},
+	blogpost.StatusArchived:  {blogpost.StatusDraft, blogpost.StatusPublished},
+}
+
+// TransitionBlogPostInput defines the input structure for changing a blog post's status.
+type TransitionBlogPostInput struct {
+	Status      string  `json:"status"`
+	PublishedAt *string `json:"published_at,omitempty"`
+}
+
+// TransitionBlogPost moves a blog post to a new status.
+// Scheduling requires a future published_at; publishing without one uses the
+// post's existing past publish date or the current time.
+func (s *BlogPostService) TransitionBlogPost(ctx context.Context, slug string, input TransitionBlogPostInput) (*ent.BlogPost, error) {
+	target := blogpost.Status(input.Status)
+	if err := blogpost.StatusValidator(target); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	var requested *time.Time
+	if input.PublishedAt != nil && *input.PublishedAt != "" {
+		parsedTime, err := parsePublishedAt(*input.PublishedAt)
+		if err != nil {
+			return nil, err
+		}
+		requested = &parsedTime
+	}
+
+	post, err := s.findBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canTransition(post.Status, target) {
+		return nil, fmt.Errorf("%w: cannot move blog post from %s to %s", ErrInvalidInput, post.Status, target)
+	}
+
+	now := time.Now()
+	postUpdate := post.Update().SetStatus(target)
+
+	switch target {
+	case blogpost.StatusScheduled:
+		if requested == nil || !requested.After(now) {
+			return nil, fmt.Errorf("%w: scheduling requires a future published_at", ErrInvalidInput)
+		}
+		postUpdate = postUpdate.SetPublishedAt(*requested)
+	case blogpost.StatusPublished:
+		switch {
+		case requested != nil:
+			if requested.After(now) {
+				return nil, fmt.Errorf("%w: published_at is in the future, schedule the post instead", ErrInvalidInput)
+			}
+			postUpdate = postUpdate.SetPublishedAt(*requested)
+		case post.PublishedAt.IsZero() || post.PublishedAt.After(now):
+			postUpdate = postUpdate.SetPublishedAt(now)
+		}
+	default:
+		if requested != nil {
+			postUpdate = postUpdate.SetPublishedAt(*requested)
+		}
+	}
+
+	updated, err := postUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update blog post status: %w", err)
+	}
+	s.imageService.resolvePosts(ctx, updated)
+	return updated, nil
+}
+
+// canTransition reports whether a post may move from one status to another.
+func canTransition(from, to blogpost.Status) bool {
+	for _, allowed := range statusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// initialStatus resolves the status of a new post. Without an explicit status,
+// a post is a draft unless a publish date is given, in which case it is
+// published or scheduled depending on whether that date has passed.
+func initialStatus(status *string, publishedAt *time.Time) (blogpost.Status, *time.Time, error) {
+	now := time.Now()
+
+	if status == nil || *status == "" {
+		switch {
+		case publishedAt == nil:
+			return blogpost.StatusDraft, nil, nil
+		case publishedAt.After(now):
+			return blogpost.StatusScheduled, publishedAt, nil
+		default:
+			return blogpost.StatusPublished, publishedAt, nil
+		}
+	}
+
+	target := blogpost.Status(*status)
+	if err := blogpost.StatusValidator(target); err != nil {
+		return "", nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	switch target {
+	case blogpost.StatusScheduled:
+		if publishedAt == nil || !publishedAt.After(now) {
+			return "", nil, fmt.Errorf("%w: scheduling requires a future published_at", ErrInvalidInput)
+		}
+	case blogpost.StatusPublished:
+		if publishedAt == nil {
+			publishedAt = &now
+		} else if publishedAt.After(now) {
+			return "", nil, fmt.Errorf("%w: published_at is in the future, schedule the post instead", ErrInvalidInput)
+		}
+	case blogpost.StatusArchived:
+		return "", nil, fmt.Errorf("%w: a new blog post cannot be archived", ErrInvalidInput)
+	}
+
+	return target, publishedAt, nil
+}
+
+// checkPublishedAt validates a publish date change against the post's status.
+// A nil publishedAt means the date is being cleared.
+func checkPublishedAt(status blogpost.Status, publishedAt *time.Time) error {
+	switch status {
+	case blogpost.StatusPublished, blogpost.StatusScheduled:
+		if publishedAt == nil {
+			return fmt.Errorf("%w: published_at cannot be cleared while the post is %s", ErrInvalidInput, status)
+		}
+		if status == blogpost.StatusPublished && publishedAt.After(time.Now()) {
+			return fmt.Errorf("%w: published_at is in the future, schedule the post instead", ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// isPubliclyVisible matches posts that readers may see: published, or
+// scheduled with a publish date that has already passed.
+func isPubliclyVisible() predicate.BlogPost {
+	return blogpost.And(
+		blogpost.StatusIn(blogpost.StatusPublished, blogpost.StatusScheduled),
+		blogpost.PublishedAtLTE(time.Now()),
+	)
+}
+
+// parsePublishedAt parses an RFC3339 publish date supplied by a client.
+func parsePublishedAt(value string) (time.Time, error) {
+	parsedTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid published_at format: %v. Use format %s", ErrInvalidInput, err, time.RFC3339)
+	}
+	return parsedTime, nil
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/schema"
+)
+
+// RestoreBlogPost takes the blog post identified by slug out of the trash,
+// with the status it had when it was deleted.
+func (s *BlogPostService) RestoreBlogPost(ctx context.Context, slug string) (*ent.BlogPost, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+	post, err := s.client.BlogPost.Query().
+		Where(blogpost.SlugEQ(slug), blogpost.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("blog post with slug '%s' in the trash %w", slug, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to retrieve blog post: %w", err)
+	}
+
+	restored, err := s.client.BlogPost.UpdateOne(post).ClearDeletedAt().Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore blog post: %w", err)
+	}
+	s.imageService.resolvePosts(ctx, restored)
+	return restored, nil
+}
+
+// PurgeTrash deletes the posts that have been in the trash for longer than
+// retention, along with their revisions and any image that predates the media
+// library, and returns them. With dryRun set it only returns them.
+// Failures are logged, and returned joined once every post was tried.
+func (s *BlogPostService) PurgeTrash(ctx context.Context, retention time.Duration, dryRun bool) ([]*ent.BlogPost, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+	expired, err := s.client.BlogPost.Query().
+		Where(blogpost.DeletedAtLT(time.Now().Add(-retention))).
+		Select(
+			blogpost.FieldTitle,
+			blogpost.FieldSlug,
+			blogpost.FieldImage,
+			blogpost.FieldImageVariants,
+			blogpost.FieldDeletedAt,
+		).
+		Order(ent.Asc(blogpost.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch trashed blog posts: %w", err)
+	}
+	if dryRun {
+		return expired, nil
+	}
+
+	var (
+		purged []*ent.BlogPost
+		errs   []error
+	)
+	for _, post := range expired {
+		if err := s.purge(ctx, post); err != nil {
+			log.Printf("Error purging blog post '%s': %v", post.Slug, err)
+			errs = append(errs, err)
+			continue
+		}
+		purged = append(purged, post)
+	}
+	return purged, errors.Join(errs...)
+}
+
+// purge deletes a trashed post for good.
+func (s *BlogPostService) purge(ctx context.Context, post *ent.BlogPost) error {
+	stale, err := s.staleUpload(ctx, post)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.BlogPost.DeleteOne(post).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete blog post: %w", err)
+	}
+
+	s.releaseUpload(ctx, stale, "")
+	return nil
+}
+
+// StartTrashPurger runs PurgeTrash every interval until ctx is done, logging the outcome.
+func (s *BlogPostService) StartTrashPurger(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				purged, err := s.PurgeTrash(ctx, retention, false)
+				if err != nil {
+					log.Printf("Error purging the trash: %v", err)
+				}
+				if len(purged) > 0 {
+					log.Printf("Purged %d blog posts from the trash", len(purged))
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/internal/storage"
+)
+
+// uploadFixture is a post whose image was uploaded for it, in local storage.
+type uploadFixture struct {
+	posts  *BlogPostService
+	client *ent.Client
+	dir    string
+	post   *ent.BlogPost
+	media  *ent.Media
+}
+
+// uploadFiles are the files of the uploadFixture post image.
+var uploadFiles = []string{"upload.jpg", "upload-320.jpg"}
+
+func newUploadFixture(t *testing.T) *uploadFixture {
+	t.Helper()
+	ctx := context.Background()
+	client := newTestClient(t)
+	dir := t.TempDir()
+	store, err := storage.NewLocal(dir, "http://localhost/images")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range uploadFiles {
+		if err := os.WriteFile(filepath.Join(dir, key), []byte("image"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	variants := []schematype.ImageVariant{{Name: "thumbnail", Key: "upload-320.jpg", Width: 320, Height: 240}}
+	m := client.Media.Create().
+		SetKey("upload.jpg").SetFormat("jpeg").SetMimeType("image/jpeg").
+		SetWidth(640).SetHeight(480).SetSize(5).SetPostUpload(true).
+		SetVariants(variants).
+		SaveX(ctx)
+	post := client.BlogPost.Create().
+		SetTitle("Uploaded").SetSlug("uploaded").SetContent("x").SetExcerpt("x").
+		SetImage("upload.jpg").SetImageVariants(variants).SetMedia(m).
+		SaveX(ctx)
+
+	images := NewImageService(client, store, ImageOptions{PublicPath: "/images"})
+	return &uploadFixture{
+		posts:  NewBlogPostService(client, images),
+		client: client,
+		dir:    dir,
+		post:   post,
+		media:  m,
+	}
+}
+
+// kept reports whether the upload's entry and files are all still there,
+// failing when only some are.
+func (f *uploadFixture) kept(t *testing.T) bool {
+	t.Helper()
+	ctx := context.Background()
+	recorded := f.client.Media.Query().ExistX(ctx)
+	for _, key := range uploadFiles {
+		if _, err := os.Stat(filepath.Join(f.dir, key)); (err == nil) != recorded {
+			t.Fatalf("%s stored = %v, but media entry recorded = %v", key, err == nil, recorded)
+		}
+	}
+	return recorded
+}
+
+func TestUpdateBlogPostReleasesUpload(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, f *uploadFixture)
+		wantKept bool
+	}{
+		{
+			name:  "unused upload is deleted",
+			setup: func(t *testing.T, f *uploadFixture) {},
+		},
+		{
+			name: "upload used by another post is kept",
+			setup: func(t *testing.T, f *uploadFixture) {
+				f.client.BlogPost.Create().
+					SetTitle("Other").SetSlug("other").SetContent("x").SetExcerpt("x").
+					SetImage("upload.jpg").SetMedia(f.media).
+					SaveX(context.Background())
+			},
+			wantKept: true,
+		},
+		{
+			name: "upload used by a trashed post is kept",
+			setup: func(t *testing.T, f *uploadFixture) {
+				f.client.BlogPost.Create().
+					SetTitle("Other").SetSlug("other").SetContent("x").SetExcerpt("x").
+					SetImage("upload.jpg").SetMedia(f.media).SetDeletedAt(time.Now()).
+					SaveX(context.Background())
+			},
+			wantKept: true,
+		},
+		{
+			name: "upload edited in the media library is kept",
+			setup: func(t *testing.T, f *uploadFixture) {
+				alt := "A photo"
+				mediaService := NewMediaService(f.client, f.posts.imageService)
+				if _, err := mediaService.UpdateMedia(context.Background(), f.media.ID, MediaInput{AltText: &alt}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantKept: true,
+		},
+		{
+			name: "upload used as an avatar is kept",
+			setup: func(t *testing.T, f *uploadFixture) {
+				f.client.Author.Create().SetName("Ann").SetSlug("ann").SetAvatar("upload.jpg").SaveX(context.Background())
+			},
+			wantKept: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newUploadFixture(t)
+			tt.setup(t, f)
+
+			_, err := f.posts.UpdateBlogPost(context.Background(), f.post.Slug, UpdateBlogPostInput{RemoveImage: true})
+			if err != nil {
+				t.Fatalf("UpdateBlogPost: %v", err)
+			}
+			if kept := f.kept(t); kept != tt.wantKept {
+				t.Errorf("upload kept = %v, want %v", kept, tt.wantKept)
+			}
+		})
+	}
+}
+
+func TestUpdateBlogPostKeepsUnchangedUpload(t *testing.T) {
+	f := newUploadFixture(t)
+
+	image, id := "upload.jpg", f.media.ID
+	_, err := f.posts.UpdateBlogPost(context.Background(), f.post.Slug, UpdateBlogPostInput{
+		Image:         &image,
+		ImageVariants: f.media.Variants,
+		MediaID:       &id,
+	})
+	if err != nil {
+		t.Fatalf("UpdateBlogPost: %v", err)
+	}
+	if !f.kept(t) {
+		t.Error("the upload was deleted although the post still uses it")
+	}
+}
+
+func TestPurgeReleasesUpload(t *testing.T) {
+	f := newUploadFixture(t)
+	ctx := context.Background()
+	f.client.BlogPost.UpdateOne(f.post).SetDeletedAt(time.Now().Add(-48 * time.Hour)).ExecX(ctx)
+
+	purged, err := f.posts.PurgeTrash(ctx, 24*time.Hour, false)
+	if err != nil {
+		t.Fatalf("PurgeTrash: %v", err)
+	}
+	if len(purged) != 1 {
+		t.Fatalf("PurgeTrash purged %d posts, want 1", len(purged))
+	}
+	if f.kept(t) {
+		t.Error("the purged post's upload was kept")
+	}
+}
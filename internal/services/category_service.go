@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/category"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+)
+
+// CategoryService provides business logic for post categories.
+type CategoryService struct {
+	client *ent.Client
+}
+
+// NewCategoryService creates a new CategoryService.
+func NewCategoryService(client *ent.Client) *CategoryService {
+	return &CategoryService{
+		client: client,
+	}
+}
+
+// CategoryInput defines the input structure for creating or updating a category.
+// On update, nil fields are left untouched and an empty ParentSlug makes the category a root.
+type CategoryInput struct {
+	Name        *string `json:"name,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ParentSlug  *string `json:"parent,omitempty"`
+}
+
+// CategoryWithCount is a category with the number of published posts filed directly under it.
+type CategoryWithCount struct {
+	*ent.Category
+	PostCount int `json:"post_count"`
+}
+
+// CreateCategory creates a new category.
+func (s *CategoryService) CreateCategory(ctx context.Context, input CategoryInput) (*ent.Category, error) {
+	return retrySlugConflict(func() (*ent.Category, error) {
+		return s.createCategory(ctx, input)
+	})
+}
+
+// createCategory makes a single attempt at CreateCategory.
+func (s *CategoryService) createCategory(ctx context.Context, input CategoryInput) (*ent.Category, error) {
+	if input.Name == nil || *input.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidInput)
+	}
+
+	slugSource := *input.Name
+	if input.Slug != nil && *input.Slug != "" {
+		slugSource = *input.Slug
+	}
+	slug, err := s.uniqueSlug(ctx, utils.GenerateSlug(slugSource), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCreate := s.client.Category.
+		Create().
+		SetName(*input.Name).
+		SetSlug(slug).
+		SetNillableDescription(input.Description)
+
+	if input.ParentSlug != nil && *input.ParentSlug != "" {
+		parent, err := s.GetCategoryBySlug(ctx, *input.ParentSlug)
+		if err != nil {
+			return nil, asInvalidInput(err)
+		}
+		categoryCreate = categoryCreate.SetParentID(parent.ID)
+	}
+
+	c, err := categoryCreate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+	return c, nil
+}
+
+// UpdateCategory applies a partial update to the category identified by slug.
+func (s *CategoryService) UpdateCategory(ctx context.Context, slug string, input CategoryInput) (*ent.Category, error) {
+	return retrySlugConflict(func() (*ent.Category, error) {
+		return s.updateCategory(ctx, slug, input)
+	})
+}
+
+// updateCategory makes a single attempt at UpdateCategory.
+func (s *CategoryService) updateCategory(ctx context.Context, slug string, input CategoryInput) (*ent.Category, error) {
+	c, err := s.GetCategoryBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryUpdate := c.Update().SetNillableDescription(input.Description)
+
+	if input.Name != nil {
+		if *input.Name == "" {
+			return nil, fmt.Errorf("%w: name cannot be empty", ErrInvalidInput)
+		}
+		categoryUpdate = categoryUpdate.SetName(*input.Name)
+	}
+
+	if input.Slug != nil && *input.Slug != "" {
+		newSlug := utils.GenerateSlug(*input.Slug)
+		if newSlug != c.Slug {
+			newSlug, err = s.uniqueSlug(ctx, newSlug, c.ID)
+			if err != nil {
+				return nil, err
+			}
+			categoryUpdate = categoryUpdate.SetSlug(newSlug)
+		}
+	}
+
+	if input.ParentSlug != nil {
+		if *input.ParentSlug == "" {
+			categoryUpdate = categoryUpdate.ClearParent()
+		} else {
+			parent, err := s.GetCategoryBySlug(ctx, *input.ParentSlug)
+			if err != nil {
+				return nil, asInvalidInput(err)
+			}
+			descendants, err := categoryTreeIDs(ctx, s.client, c.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range descendants {
+				if id == parent.ID {
+					return nil, fmt.Errorf("%w: a category cannot be moved under itself or its descendants", ErrInvalidInput)
+				}
+			}
+			categoryUpdate = categoryUpdate.SetParentID(parent.ID)
+		}
+	}
+
+	updated, err := categoryUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteCategory deletes the category identified by slug. Its posts become
+// uncategorized and its children become root categories.
+func (s *CategoryService) DeleteCategory(ctx context.Context, slug string) error {
+	c, err := s.GetCategoryBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Category.DeleteOne(c).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+	return nil
+}
+
+// GetCategories lists all categories ordered by name, with published post counts.
+func (s *CategoryService) GetCategories(ctx context.Context) ([]*CategoryWithCount, error) {
+	categories, err := s.client.Category.Query().
+		Order(ent.Asc(category.FieldName), ent.Asc(category.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Printf("Error fetching categories: %v", err)
+		return nil, fmt.Errorf("failed to fetch categories: %w", err)
+	}
+
+	var counts []struct {
+		CategoryID int `sql:"category_id"`
+		Count      int `sql:"count"`
+	}
+	err = s.client.BlogPost.Query().
+		Where(isPubliclyVisible(), blogpost.HasCategory()).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				sql.As(s.C(blogpost.CategoryColumn), "category_id"),
+				sql.As(sql.Count("*"), "count"),
+			).GroupBy(s.C(blogpost.CategoryColumn))
+		}).
+		Scan(ctx, &counts)
+	if err != nil {
+		log.Printf("Error counting category posts: %v", err)
+		return nil, fmt.Errorf("failed to count category posts: %w", err)
+	}
+
+	countByID := make(map[int]int, len(counts))
+	for _, c := range counts {
+		countByID[c.CategoryID] = c.Count
+	}
+
+	result := make([]*CategoryWithCount, len(categories))
+	for i, c := range categories {
+		result[i] = &CategoryWithCount{Category: c, PostCount: countByID[c.ID]}
+	}
+	return result, nil
+}
+
+// GetCategoryBySlug retrieves a single category with its parent and children.
+func (s *CategoryService) GetCategoryBySlug(ctx context.Context, slug string) (*ent.Category, error) {
+	c, err := s.client.Category.Query().
+		Where(category.SlugEQ(slug)).
+		WithParent().
+		WithChildren().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("category with slug '%s' %w", slug, ErrNotFound)
+		}
+		log.Printf("Error fetching category by slug '%s': %v", slug, err)
+		return nil, fmt.Errorf("failed to retrieve category: %w", err)
+	}
+	return c, nil
+}
+
+// categoryTreeIDs returns the ID of the category and of every category below it.
+func categoryTreeIDs(ctx context.Context, client *ent.Client, id int) ([]int, error) {
+	all, err := client.Category.Query().
+		Select(category.FieldID, category.FieldParentID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch categories: %w", err)
+	}
+
+	children := make(map[int][]int)
+	for _, c := range all {
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c.ID)
+		}
+	}
+
+	ids := []int{id}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, children[ids[i]]...)
+	}
+	return ids, nil
+}
+
+// uniqueSlug returns slug, or slug with the lowest free suffix from -2 up if
+// another category (other than excludeID) already uses it.
+func (s *CategoryService) uniqueSlug(ctx context.Context, slug string, excludeID int) (string, error) {
+	if slug == "" {
+		return "", fmt.Errorf("%w: slug cannot be empty", ErrInvalidInput)
+	}
+	taken, err := s.client.Category.Query().
+		Where(slugFamily[predicate.Category](slug), category.IDNEQ(excludeID)).
+		Select(category.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for existing category slug: %w", err)
+	}
+	return nextFreeSlug(slug, taken), nil
+}
+
+// withCategorySummary loads only the category fields shown on a post.
+func withCategorySummary(q *ent.CategoryQuery) {
+	q.Select(category.FieldName, category.FieldSlug)
+}
+
+// asInvalidInput turns a not-found lookup of a referenced record into an input error.
+func asInvalidInput(err error) error {
+	if errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return err
+}
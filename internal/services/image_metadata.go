@@ -0,0 +1,503 @@
+package services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+)
+
+// orientedJPEGQuality is the JPEG quality used when a photo is rotated to its EXIF orientation.
+const orientedJPEGQuality = 90
+
+// errMalformedImage is returned by the metadata strippers for images whose structure cannot be walked.
+var errMalformedImage = errors.New("malformed image")
+
+// sanitizeImage applies the EXIF orientation of an uploaded image and strips its
+// metadata (EXIF, XMP, IPTC, comments), returning the bytes to store and their
+// final format and dimensions. Images that need rotating are re-encoded, which
+// drops all metadata; the others are rewritten without their metadata blocks,
+// leaving the pixel data untouched.
+func sanitizeImage(data []byte, info *imageInfo) ([]byte, *imageInfo, error) {
+	if orientation := exifOrientation(data, info.format); orientation > 1 {
+		return orientImage(data, info, orientation)
+	}
+
+	var (
+		clean []byte
+		err   error
+	)
+	switch info.format {
+	case formatJPEG:
+		clean, err = stripJPEG(data)
+	case formatPNG:
+		clean, err = stripPNG(data)
+	case formatWebP:
+		clean, err = stripWebP(data)
+	case formatGIF:
+		clean, err = stripGIF(data)
+	case formatAVIF:
+		// AVIF metadata items cannot be removed without rewriting the file's item
+		// locations, so files that carry any are refused instead
+		if avifHasMetadata(data) {
+			return nil, nil, fmt.Errorf("%w: AVIF images with embedded EXIF or XMP metadata are not accepted", ErrUnsupportedMediaType)
+		}
+		clean = data
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed %s image", ErrUnsupportedMediaType, info.format.name)
+	}
+	return clean, info, nil
+}
+
+// orientImage decodes the image, rotates or flips it upright and re-encodes it.
+// WebP images, which cannot be encoded, are converted to JPEG or PNG.
+func orientImage(data []byte, info *imageInfo, orientation int) ([]byte, *imageInfo, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed %s image", ErrUnsupportedMediaType, info.format.name)
+	}
+
+	upright := applyOrientation(img, orientation)
+
+	var format *imageFormat
+	if info.format == formatJPEG || info.format == formatPNG {
+		format = info.format
+	}
+	out, format, err := encodeImage(upright, format, orientedJPEGQuality)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	bounds := upright.Bounds()
+	return out, &imageInfo{format: format, width: bounds.Dx(), height: bounds.Dy()}, nil
+}
+
+// applyOrientation returns img transformed so that it displays upright, given
+// an EXIF orientation between 2 and 8.
+func applyOrientation(img image.Image, orientation int) *image.NRGBA {
+	b := img.Bounds()
+	src := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		// Orientations 5 to 8 swap the axes
+		dw, dh = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored horizontally
+				dx, dy = w-1-x, y
+			case 3: // rotated 180°
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // mirrored along the top-left diagonal
+				dx, dy = y, x
+			case 6: // rotated 90° counter-clockwise, so turn clockwise
+				dx, dy = h-1-y, x
+			case 7: // mirrored along the top-right diagonal
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotated 90° clockwise, so turn counter-clockwise
+				dx, dy = y, w-1-x
+			default:
+				dx, dy = x, y
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], src.Pix[src.PixOffset(x, y):src.PixOffset(x, y)+4])
+		}
+	}
+	return dst
+}
+
+// exifOrientation returns the EXIF orientation (1 to 8) recorded in the image, or 1.
+func exifOrientation(data []byte, format *imageFormat) int {
+	var tiff []byte
+	switch format {
+	case formatJPEG:
+		walkJPEGSegments(data, func(marker byte, payload []byte) {
+			if marker == 0xE1 && bytes.HasPrefix(payload, []byte("Exif\x00\x00")) && tiff == nil {
+				tiff = payload[6:]
+			}
+		})
+	case formatPNG:
+		walkPNGChunks(data, func(typ string, chunk []byte) {
+			if typ == "eXIf" && tiff == nil {
+				tiff = chunk[8 : len(chunk)-4]
+			}
+		})
+	case formatWebP:
+		walkWebPChunks(data, func(fourCC string, chunk []byte) {
+			if fourCC == "EXIF" && tiff == nil {
+				tiff = bytes.TrimPrefix(chunk[8:], []byte("Exif\x00\x00"))
+			}
+		})
+	}
+	return tiffOrientation(tiff)
+}
+
+// tiffOrientation reads the Orientation tag from the first IFD of a TIFF-encoded EXIF block.
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + 12*i
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			if v := int(order.Uint16(tiff[entry+8:])); v >= 1 && v <= 8 {
+				return v
+			}
+			break
+		}
+	}
+	return 1
+}
+
+// walkJPEGSegments calls fn with the marker and payload of each segment before
+// the image data. It returns the offset of the start-of-scan marker.
+func walkJPEGSegments(data []byte, fn func(marker byte, payload []byte)) (int, error) {
+	i := 2 // after SOI
+	for i+4 <= len(data) {
+		if data[i] != 0xFF {
+			return 0, errMalformedImage
+		}
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF:
+			// Fill byte
+			i++
+			continue
+		case marker == 0xDA || marker == 0xD9:
+			// Start of scan or end of image
+			return i, nil
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 0, errMalformedImage
+		}
+		fn(marker, data[i+4:i+2+length])
+		i += 2 + length
+	}
+	return 0, errMalformedImage
+}
+
+// keepJPEGSegment reports whether a segment is kept by stripJPEG: APPn segments
+// other than JFIF, ICC profiles and Adobe color information, and comments, are dropped.
+func keepJPEGSegment(marker byte, payload []byte) bool {
+	switch {
+	case marker == 0xE0:
+		return bytes.HasPrefix(payload, []byte("JFIF\x00"))
+	case marker == 0xE2:
+		return bytes.HasPrefix(payload, []byte("ICC_PROFILE\x00"))
+	case marker == 0xEE:
+		return bytes.HasPrefix(payload, []byte("Adobe"))
+	case marker >= 0xE1 && marker <= 0xEF, marker == 0xFE:
+		return false
+	}
+	return true
+}
+
+// stripJPEG removes the metadata segments dropped by keepJPEGSegment wherever
+// they appear, including between the scans of progressive images, and
+// everything after the end-of-image marker, such as MPF secondary images with
+// their own EXIF or any appended payload.
+func stripJPEG(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, errMalformedImage
+	}
+	out := []byte{0xFF, 0xD8}
+	scanned := false
+	i := 2 // after SOI
+	for i+2 <= len(data) {
+		if data[i] != 0xFF {
+			return nil, errMalformedImage
+		}
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF:
+			// Fill byte
+			i++
+			continue
+		case marker == 0xD9:
+			if !scanned {
+				return nil, errMalformedImage
+			}
+			return append(out, 0xFF, 0xD9), nil
+		case marker >= 0xD0 && marker <= 0xD7, marker == 0x01:
+			// Restart and TEM markers carry no payload
+			out = append(out, data[i:i+2]...)
+			i += 2
+			continue
+		}
+
+		if i+4 > len(data) {
+			return nil, errMalformedImage
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, errMalformedImage
+		}
+		if keepJPEGSegment(marker, data[i+4:end]) {
+			out = append(out, data[i:end]...)
+		}
+		i = end
+		if marker != 0xDA {
+			continue
+		}
+
+		// Entropy-coded data runs to the next marker other than a stuffed
+		// zero byte or a restart marker
+		scanned = true
+		start := i
+		for {
+			next := bytes.IndexByte(data[i:], 0xFF)
+			if next < 0 || i+next+1 >= len(data) {
+				i = len(data)
+				break
+			}
+			i += next
+			if b := data[i+1]; b != 0x00 && (b < 0xD0 || b > 0xD7) {
+				break
+			}
+			i += 2
+		}
+		out = append(out, data[start:i]...)
+	}
+	if !scanned {
+		return nil, errMalformedImage
+	}
+	// A file cut short within its last scan is kept as is, as decoders show
+	// what they can of it
+	return out, nil
+}
+
+// walkPNGChunks calls fn with the type and full bytes (length, type, data, CRC) of each chunk.
+func walkPNGChunks(data []byte, fn func(typ string, chunk []byte)) error {
+	i := 8 // after the signature
+	for i < len(data) {
+		if i+12 > len(data) {
+			return errMalformedImage
+		}
+		length := int(binary.BigEndian.Uint32(data[i:]))
+		end := i + 12 + length
+		if length < 0 || end > len(data) {
+			return errMalformedImage
+		}
+		typ := string(data[i+4 : i+8])
+		fn(typ, data[i:end])
+		i = end
+		if typ == "IEND" {
+			break
+		}
+	}
+	return nil
+}
+
+// stripPNG removes the EXIF, text and timestamp chunks.
+func stripPNG(data []byte) ([]byte, error) {
+	if len(data) < 8 {
+		return nil, errMalformedImage
+	}
+	out := append([]byte(nil), data[:8]...)
+	err := walkPNGChunks(data, func(typ string, chunk []byte) {
+		switch typ {
+		case "eXIf", "tEXt", "zTXt", "iTXt", "tIME":
+		default:
+			out = append(out, chunk...)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// walkWebPChunks calls fn with the FourCC and full bytes (header, data, padding) of each RIFF chunk.
+func walkWebPChunks(data []byte, fn func(fourCC string, chunk []byte)) error {
+	i := 12 // after the RIFF header
+	for i < len(data) {
+		if i+8 > len(data) {
+			return errMalformedImage
+		}
+		size := int(binary.LittleEndian.Uint32(data[i+4:]))
+		end := i + 8 + size + size%2
+		if size < 0 || end > len(data) {
+			// Some encoders omit the final padding byte
+			if end-1 == len(data) && size%2 == 1 {
+				end = len(data)
+			} else {
+				return errMalformedImage
+			}
+		}
+		fn(string(data[i:i+4]), data[i:end])
+		i = end
+	}
+	return nil
+}
+
+// stripWebP removes the EXIF and XMP chunks and clears their flags in the extended header.
+func stripWebP(data []byte) ([]byte, error) {
+	if len(data) < 12 {
+		return nil, errMalformedImage
+	}
+	out := append([]byte(nil), data[:12]...)
+	err := walkWebPChunks(data, func(fourCC string, chunk []byte) {
+		switch fourCC {
+		case "EXIF", "XMP ":
+			return
+		case "VP8X":
+			chunk = append([]byte(nil), chunk...)
+			if len(chunk) > 8 {
+				chunk[8] &^= 0x08 | 0x04 // EXIF and XMP present flags
+			}
+		}
+		out = append(out, chunk...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(out)-8))
+	return out, nil
+}
+
+// stripGIF removes comment extensions and application extensions other than
+// the animation loop settings (which is where XMP packets live).
+func stripGIF(data []byte) ([]byte, error) {
+	if len(data) < 13 {
+		return nil, errMalformedImage
+	}
+	i := 13 // header and logical screen descriptor
+	if flags := data[10]; flags&0x80 != 0 {
+		i += 3 << ((flags & 0x07) + 1) // global color table
+	}
+	if i > len(data) {
+		return nil, errMalformedImage
+	}
+	out := append([]byte(nil), data[:i]...)
+
+	// skipSubBlocks returns the offset after the data sub-blocks starting at j
+	skipSubBlocks := func(j int) (int, error) {
+		for j < len(data) {
+			n := int(data[j])
+			j++
+			if n == 0 {
+				return j, nil
+			}
+			j += n
+		}
+		return 0, errMalformedImage
+	}
+
+	for i < len(data) {
+		start := i
+		switch data[i] {
+		case 0x3B: // trailer
+			return append(out, 0x3B), nil
+		case 0x21: // extension
+			if i+2 > len(data) {
+				return nil, errMalformedImage
+			}
+			label := data[i+1]
+			end, err := skipSubBlocks(i + 2)
+			if err != nil {
+				return nil, err
+			}
+			keep := true
+			switch label {
+			case 0xFE: // comment
+				keep = false
+			case 0xFF: // application
+				app := data[i+2 : min(i+14, len(data))]
+				keep = bytes.HasPrefix(app, []byte("\x0bNETSCAPE2.0")) || bytes.HasPrefix(app, []byte("\x0bANIMEXTS1.0"))
+			}
+			if keep {
+				out = append(out, data[start:end]...)
+			}
+			i = end
+		case 0x2C: // image descriptor
+			if i+10 > len(data) {
+				return nil, errMalformedImage
+			}
+			j := i + 10
+			if flags := data[i+9]; flags&0x80 != 0 {
+				j += 3 << ((flags & 0x07) + 1) // local color table
+			}
+			end, err := skipSubBlocks(j + 1) // after the LZW minimum code size
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, data[start:end]...)
+			i = end
+		default:
+			return nil, errMalformedImage
+		}
+	}
+	// Tolerate a missing trailer
+	return append(out, 0x3B), nil
+}
+
+// avifHasMetadata reports whether an AVIF file declares EXIF or XMP items.
+func avifHasMetadata(data []byte) bool {
+	meta := findBox(data, "meta")
+	if len(meta) < 4 {
+		return false
+	}
+	iinf := findBox(meta[4:], "iinf")
+	if len(iinf) < 6 {
+		return false
+	}
+	// iinf is a full box with a 16-bit (version 0) or 32-bit entry count
+	entries := iinf[6:]
+	if iinf[0] != 0 {
+		if len(iinf) < 8 {
+			return false
+		}
+		entries = iinf[8:]
+	}
+
+	found := false
+	walkBoxes(entries, func(typ string, payload []byte) bool {
+		if typ != "infe" || len(payload) < 1 || payload[0] < 2 {
+			return true
+		}
+		// version, flags, item ID (16 or 32 bits), protection index, item type
+		at := 4 + 2 + 2
+		if payload[0] >= 3 {
+			at += 2
+		}
+		if len(payload) >= at+4 {
+			if itemType := string(payload[at : at+4]); itemType == "Exif" || itemType == "mime" {
+				found = true
+				return false
+			}
+		}
+		return true
+	})
+	return found
+}
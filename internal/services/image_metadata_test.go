@@ -0,0 +1,696 @@
+package services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// testImage returns a 32×16 white image whose top-left quarter is red, so
+// orientation changes can be told apart even after lossy compression.
+func testImage() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 32, 16))
+	for i := range img.Pix {
+		img.Pix[i] = 0xFF
+	}
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 16; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: 0xFF, A: 0xFF})
+		}
+	}
+	return img
+}
+
+// exifTIFF returns a TIFF-encoded EXIF block with a single Orientation entry.
+func exifTIFF(order binary.ByteOrder, orientation uint16) []byte {
+	b := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(b, "II")
+	} else {
+		copy(b, "MM")
+	}
+	order.PutUint16(b[2:], 42)
+	order.PutUint32(b[4:], 8)
+	order.PutUint16(b[8:], 1)
+	order.PutUint16(b[10:], 0x0112) // Orientation
+	order.PutUint16(b[12:], 3)      // SHORT
+	order.PutUint32(b[14:], 1)
+	order.PutUint16(b[18:], orientation)
+	return b
+}
+
+// jpegSegment returns a marker segment with the given payload.
+func jpegSegment(marker byte, payload []byte) []byte {
+	seg := []byte{0xFF, marker}
+	seg = binary.BigEndian.AppendUint16(seg, uint16(len(payload)+2))
+	return append(seg, payload...)
+}
+
+// testJPEG encodes testImage as a JPEG with segments inserted after SOI.
+func testJPEG(t testing.TB, segments ...[]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, testImage(), nil); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	out := append([]byte(nil), data[:2]...)
+	for _, seg := range segments {
+		out = append(out, seg...)
+	}
+	return append(out, data[2:]...)
+}
+
+// pngChunk returns a PNG chunk with a valid CRC.
+func pngChunk(typ string, data []byte) []byte {
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	chunk = append(chunk, typ...)
+	chunk = append(chunk, data...)
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+// testPNG encodes testImage as a PNG with chunks inserted after IHDR.
+func testPNG(t testing.TB, chunks ...[]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage()); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	ihdrEnd := 8 + 12 + 13
+	out := append([]byte(nil), data[:ihdrEnd]...)
+	for _, chunk := range chunks {
+		out = append(out, chunk...)
+	}
+	return append(out, data[ihdrEnd:]...)
+}
+
+// webpChunk returns a RIFF chunk, padded to an even size.
+func webpChunk(fourCC string, data []byte) []byte {
+	chunk := append([]byte(fourCC), binary.LittleEndian.AppendUint32(nil, uint32(len(data)))...)
+	chunk = append(chunk, data...)
+	if len(data)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+// testWebP builds an extended WebP file from chunks. The strippers only walk
+// the container, so the image chunk need not hold a decodable bitstream.
+func testWebP(chunks ...[]byte) []byte {
+	var body []byte
+	for _, chunk := range chunks {
+		body = append(body, chunk...)
+	}
+	out := []byte("RIFF")
+	out = binary.LittleEndian.AppendUint32(out, uint32(4+len(body)))
+	out = append(out, "WEBP"...)
+	return append(out, body...)
+}
+
+// vp8x returns a VP8X chunk with the given feature flags for a 32×16 canvas.
+func vp8x(flags byte) []byte {
+	return webpChunk("VP8X", []byte{flags, 0, 0, 0, 31, 0, 0, 15, 0, 0})
+}
+
+// gifExtension returns a GIF extension block holding data in one sub-block.
+func gifExtension(label byte, data []byte) []byte {
+	ext := []byte{0x21, label, byte(len(data))}
+	ext = append(ext, data...)
+	return append(ext, 0)
+}
+
+// testGIF encodes testImage as a GIF with blocks inserted before the image descriptor.
+func testGIF(t testing.TB, blocks ...[]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := gif.Encode(&buf, testImage(), nil); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	desc := gifHeaderLen(data)
+	if desc >= len(data) || data[desc] != 0x2C {
+		t.Fatal("no image descriptor after the GIF header")
+	}
+	out := append([]byte(nil), data[:desc]...)
+	for _, block := range blocks {
+		out = append(out, block...)
+	}
+	return append(out, data[desc:]...)
+}
+
+// gifHeaderLen returns the length of a GIF's header, screen descriptor and global color table.
+func gifHeaderLen(data []byte) int {
+	n := 13
+	if flags := data[10]; flags&0x80 != 0 {
+		n += 3 << ((flags & 0x07) + 1)
+	}
+	return n
+}
+
+// jpegMetadata lists the markers of the metadata segments left in a JPEG,
+// wherever they are: before the first scan, between scans or after the end
+// of the image.
+func jpegMetadata(data []byte) []byte {
+	var markers []byte
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF || data[i+1] == 0x00 || data[i+1] == 0xFF || data[i+1] >= 0xD0 && data[i+1] <= 0xD9 {
+			// Entropy-coded data, a fill byte or a marker without payload
+			i++
+			continue
+		}
+		marker, length := data[i+1], int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			i++
+			continue
+		}
+		payload := data[i+4 : i+2+length]
+		switch {
+		case marker == 0xE0 && bytes.HasPrefix(payload, []byte("JFIF\x00")),
+			marker == 0xE2 && bytes.HasPrefix(payload, []byte("ICC_PROFILE\x00")),
+			marker == 0xEE && bytes.HasPrefix(payload, []byte("Adobe")):
+		case marker >= 0xE0 && marker <= 0xEF, marker == 0xFE:
+			markers = append(markers, marker)
+		}
+		i += 2 + length
+	}
+	return markers
+}
+
+// insertBeforeEOI inserts segments between the last scan of a JPEG and its end-of-image marker.
+func insertBeforeEOI(data []byte, segments ...[]byte) []byte {
+	out := append([]byte(nil), data[:len(data)-2]...)
+	for _, seg := range segments {
+		out = append(out, seg...)
+	}
+	return append(out, 0xFF, 0xD9)
+}
+
+// pngMetadata lists the metadata chunks left in a PNG.
+func pngMetadata(data []byte) []string {
+	var types []string
+	walkPNGChunks(data, func(typ string, chunk []byte) {
+		switch typ {
+		case "eXIf", "tEXt", "zTXt", "iTXt", "tIME":
+			types = append(types, typ)
+		}
+	})
+	return types
+}
+
+// webpMetadata lists the metadata chunks left in a WebP, and whether VP8X still flags any.
+func webpMetadata(data []byte) (fourCCs []string, flagged bool) {
+	walkWebPChunks(data, func(fourCC string, chunk []byte) {
+		switch fourCC {
+		case "EXIF", "XMP ":
+			fourCCs = append(fourCCs, fourCC)
+		case "VP8X":
+			flagged = len(chunk) > 8 && chunk[8]&(0x08|0x04) != 0
+		}
+	})
+	return fourCCs, flagged
+}
+
+func TestStripJPEG(t *testing.T) {
+	exif := append([]byte("Exif\x00\x00"), exifTIFF(binary.BigEndian, 1)...)
+	xmp := []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta/>")
+
+	tests := []struct {
+		name     string
+		data     []byte
+		wantErr  bool
+		wantKeep []byte
+	}{
+		{name: "no metadata", data: testJPEG(t)},
+		{
+			name: "exif, xmp, iptc and comment",
+			data: testJPEG(t,
+				jpegSegment(0xE1, exif),
+				jpegSegment(0xE1, xmp),
+				jpegSegment(0xED, []byte("Photoshop 3.0\x00iptc")),
+				jpegSegment(0xFE, []byte("secret comment")),
+			),
+		},
+		{
+			name:     "keeps icc profile",
+			data:     testJPEG(t, jpegSegment(0xE2, []byte("ICC_PROFILE\x00\x01\x01profile"))),
+			wantKeep: []byte("ICC_PROFILE"),
+		},
+		{
+			name: "segments between scan and end of image",
+			data: insertBeforeEOI(testJPEG(t),
+				jpegSegment(0xE1, exif),
+				jpegSegment(0xFE, []byte("secret comment")),
+			),
+		},
+		{name: "empty", data: nil, wantErr: true},
+		{name: "only soi", data: []byte{0xFF, 0xD8}, wantErr: true},
+		{name: "no scan", data: []byte{0xFF, 0xD8, 0xFF, 0xD9}, wantErr: true},
+		{name: "truncated segment", data: testJPEG(t, jpegSegment(0xE1, exif))[:20], wantErr: true},
+		{
+			name:    "oversized length",
+			data:    append([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF, 0xFF}, exif...),
+			wantErr: true,
+		},
+		{name: "length below two", data: []byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, 0x01, 0xFF, 0xDA}, wantErr: true},
+		{name: "missing marker", data: []byte{0xFF, 0xD8, 0x00, 0xE1, 0x00, 0x04, 0x00, 0x00}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := stripJPEG(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("stripJPEG: %v", err)
+			}
+			if left := jpegMetadata(out); len(left) > 0 {
+				t.Errorf("metadata segments left: % X", left)
+			}
+			if bytes.Contains(out, []byte("secret comment")) || bytes.Contains(out, []byte("xmpmeta")) {
+				t.Error("metadata content left in output")
+			}
+			if tt.wantKeep != nil && !bytes.Contains(out, tt.wantKeep) {
+				t.Errorf("%q was not kept", tt.wantKeep)
+			}
+			if _, err := jpeg.Decode(bytes.NewReader(out)); err != nil {
+				t.Errorf("stripped JPEG does not decode: %v", err)
+			}
+		})
+	}
+}
+
+func TestStripJPEGCutsAfterEndOfImage(t *testing.T) {
+	// A secondary image, as in MPF files, carrying its own EXIF with GPS data
+	gps := append([]byte("Exif\x00\x00"), exifTIFF(binary.BigEndian, 1)...)
+	gps = append(gps, "GPSLatitude 51.5074 N"...)
+	secondary := testJPEG(t, jpegSegment(0xE1, gps))
+
+	primary := testJPEG(t)
+	for _, tt := range []struct {
+		name    string
+		trailer []byte
+	}{
+		{name: "mpf secondary image", trailer: secondary},
+		{name: "raw exif blob", trailer: jpegSegment(0xE1, gps)},
+		{name: "appended payload", trailer: []byte("<?php echo 'GPSLatitude'; ?>")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			data := append(append([]byte(nil), primary...), tt.trailer...)
+			out, _, err := sanitizeImage(data, &imageInfo{format: formatJPEG, width: 32, height: 16})
+			if err != nil {
+				t.Fatalf("sanitizeImage: %v", err)
+			}
+			if bytes.Contains(out, []byte("GPS")) {
+				t.Error("GPS data left in output")
+			}
+			if !bytes.Equal(out, primary) {
+				t.Errorf("output is %d bytes, want the %d bytes of the primary image ending at its EOI", len(out), len(primary))
+			}
+		})
+	}
+}
+
+func TestStripPNG(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{name: "no metadata", data: testPNG(t)},
+		{
+			name: "exif, text and time",
+			data: testPNG(t,
+				pngChunk("eXIf", exifTIFF(binary.LittleEndian, 1)),
+				pngChunk("tEXt", []byte("Comment\x00secret comment")),
+				pngChunk("iTXt", []byte("XML:com.adobe.xmp\x00\x00\x00\x00\x00<x:xmpmeta/>")),
+				pngChunk("zTXt", []byte("Author\x00\x00x")),
+				pngChunk("tIME", []byte{0x07, 0xE9, 1, 1, 0, 0, 0}),
+			),
+		},
+		{name: "empty", data: nil, wantErr: true},
+		{name: "signature only", data: testPNG(t)[:8]},
+		{name: "truncated chunk header", data: testPNG(t)[:14], wantErr: true},
+		{name: "truncated chunk data", data: testPNG(t)[:25], wantErr: true},
+		{
+			name:    "oversized length",
+			data:    append(testPNG(t)[:33], 0xFF, 0xFF, 0xFF, 0xF0, 't', 'E', 'X', 't', 0, 0, 0, 0),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := stripPNG(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("stripPNG: %v", err)
+			}
+			if left := pngMetadata(out); len(left) > 0 {
+				t.Errorf("metadata chunks left: %v", left)
+			}
+			if bytes.Contains(out, []byte("secret comment")) || bytes.Contains(out, []byte("xmpmeta")) {
+				t.Error("metadata content left in output")
+			}
+			if len(tt.data) > 8 {
+				if _, err := png.Decode(bytes.NewReader(out)); err != nil {
+					t.Errorf("stripped PNG does not decode: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestStripWebP(t *testing.T) {
+	image := webpChunk("VP8L", []byte{0x2F, 3, 0x40, 0, 0, 0})
+	exif := webpChunk("EXIF", exifTIFF(binary.LittleEndian, 1))
+	xmp := webpChunk("XMP ", []byte("<x:xmpmeta/>"))
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{name: "simple", data: testWebP(image)},
+		{name: "exif and xmp", data: testWebP(vp8x(0x08|0x04), image, exif, xmp)},
+		{name: "odd chunk without final padding", data: testWebP(image, xmp, webpChunk("ALPH", []byte{1}))[:12+len(image)+len(xmp)+9]},
+		{name: "empty", data: nil, wantErr: true},
+		{name: "header only", data: testWebP()},
+		{name: "truncated chunk header", data: testWebP(image)[:16], wantErr: true},
+		{name: "truncated chunk data", data: testWebP(vp8x(0x08), image, exif)[:40], wantErr: true},
+		{
+			name:    "oversized size",
+			data:    append(testWebP(image), 'E', 'X', 'I', 'F', 0xFF, 0xFF, 0xFF, 0x7F),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := stripWebP(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("stripWebP: %v", err)
+			}
+			left, flagged := webpMetadata(out)
+			if len(left) > 0 || flagged {
+				t.Errorf("metadata left: chunks %v, flagged %v", left, flagged)
+			}
+			if size := binary.LittleEndian.Uint32(out[4:]); int(size) != len(out)-8 {
+				t.Errorf("RIFF size %d, want %d", size, len(out)-8)
+			}
+		})
+	}
+}
+
+func TestStripGIF(t *testing.T) {
+	header := testGIF(t)[:gifHeaderLen(testGIF(t))]
+	netscape := gifExtension(0xFF, []byte("NETSCAPE2.0"))
+	netscape = append(netscape[:len(netscape)-1], 3, 1, 0, 0, 0)
+	xmp := gifExtension(0xFF, []byte("XMP DataXMP<x:xmpmeta/>"))
+
+	tests := []struct {
+		name     string
+		data     []byte
+		wantErr  bool
+		wantKeep []byte
+	}{
+		{name: "no metadata", data: testGIF(t)},
+		{
+			name:     "comment and xmp",
+			data:     testGIF(t, gifExtension(0xFE, []byte("secret comment")), xmp, netscape),
+			wantKeep: []byte("NETSCAPE2.0"),
+		},
+		{name: "missing trailer", data: bytes.TrimSuffix(testGIF(t), []byte{0x3B})},
+		{name: "empty", data: nil, wantErr: true},
+		{name: "short header", data: testGIF(t)[:12], wantErr: true},
+		{
+			name:    "color table past the end",
+			data:    []byte("GIF89a\x04\x00\x02\x00\x87\x00\x00"),
+			wantErr: true,
+		},
+		{
+			name:    "unterminated comment",
+			data:    append(bytes.Clone(header), 0x21, 0xFE, 0x10, 'a', 'b'),
+			wantErr: true,
+		},
+		{
+			name:    "oversized sub-block",
+			data:    append(bytes.Clone(header), 0x21, 0xFE, 0xFF, 'a', 0),
+			wantErr: true,
+		},
+		{name: "unknown block", data: append(bytes.Clone(header), 0x99), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := stripGIF(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("stripGIF: %v", err)
+			}
+			if bytes.Contains(out, []byte("secret comment")) || bytes.Contains(out, []byte("xmpmeta")) {
+				t.Error("metadata content left in output")
+			}
+			if tt.wantKeep != nil && !bytes.Contains(out, tt.wantKeep) {
+				t.Errorf("%q was not kept", tt.wantKeep)
+			}
+			if _, err := gif.Decode(bytes.NewReader(out)); err != nil {
+				t.Errorf("stripped GIF does not decode: %v", err)
+			}
+		})
+	}
+}
+
+func TestExifOrientation(t *testing.T) {
+	exifJPEG := func(tiff []byte) []byte {
+		return testJPEG(t, jpegSegment(0xE1, append([]byte("Exif\x00\x00"), tiff...)))
+	}
+
+	tests := []struct {
+		name   string
+		data   []byte
+		format *imageFormat
+		want   int
+	}{
+		{name: "jpeg without exif", data: testJPEG(t), format: formatJPEG, want: 1},
+		{name: "jpeg big endian", data: exifJPEG(exifTIFF(binary.BigEndian, 6)), format: formatJPEG, want: 6},
+		{name: "jpeg little endian", data: exifJPEG(exifTIFF(binary.LittleEndian, 8)), format: formatJPEG, want: 8},
+		{name: "jpeg out of range", data: exifJPEG(exifTIFF(binary.BigEndian, 9)), format: formatJPEG, want: 1},
+		{name: "jpeg bad byte order", data: exifJPEG(append([]byte("XX"), exifTIFF(binary.BigEndian, 6)[2:]...)), format: formatJPEG, want: 1},
+		{
+			name:   "jpeg ifd offset past the end",
+			data:   exifJPEG(append([]byte("MM\x00\x2A\xFF\xFF\xFF\xF0"), exifTIFF(binary.BigEndian, 6)[8:]...)),
+			format: formatJPEG,
+			want:   1,
+		},
+		{
+			name:   "jpeg entry count past the end",
+			data:   exifJPEG(append([]byte("MM\x00\x2A\x00\x00\x00\x08\xFF\xFF"), exifTIFF(binary.BigEndian, 6)[10:]...)),
+			format: formatJPEG,
+			want:   6,
+		},
+		{name: "jpeg truncated tiff", data: exifJPEG([]byte("MM\x00")), format: formatJPEG, want: 1},
+		{name: "png", data: testPNG(t, pngChunk("eXIf", exifTIFF(binary.BigEndian, 3))), format: formatPNG, want: 3},
+		{name: "webp", data: testWebP(vp8x(0x08), webpChunk("EXIF", exifTIFF(binary.LittleEndian, 5))), format: formatWebP, want: 5},
+		{
+			name:   "webp with exif header",
+			data:   testWebP(vp8x(0x08), webpChunk("EXIF", append([]byte("Exif\x00\x00"), exifTIFF(binary.LittleEndian, 7)...))),
+			format: formatWebP,
+			want:   7,
+		},
+		{name: "gif", data: testGIF(t), format: formatGIF, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exifOrientation(tt.data, tt.format); got != tt.want {
+				t.Errorf("exifOrientation = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeImageOrientation(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        []byte
+		format      *imageFormat
+		orientation int
+		// width and height of the upright image, and where the red pixel at (4, 2) lands
+		width, height int
+		red           image.Point
+	}{
+		{name: "jpeg 3", format: formatJPEG, orientation: 3, width: 32, height: 16, red: image.Pt(27, 13)},
+		{name: "jpeg 6", format: formatJPEG, orientation: 6, width: 16, height: 32, red: image.Pt(13, 4)},
+		{name: "png 2", format: formatPNG, orientation: 2, width: 32, height: 16, red: image.Pt(27, 2)},
+		{name: "png 8", format: formatPNG, orientation: 8, width: 16, height: 32, red: image.Pt(2, 27)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data []byte
+			if tt.format == formatJPEG {
+				exif := append([]byte("Exif\x00\x00"), exifTIFF(binary.BigEndian, uint16(tt.orientation))...)
+				data = testJPEG(t, jpegSegment(0xE1, exif))
+			} else {
+				data = testPNG(t, pngChunk("eXIf", exifTIFF(binary.BigEndian, uint16(tt.orientation))))
+			}
+
+			out, info, err := sanitizeImage(data, &imageInfo{format: tt.format, width: 32, height: 16})
+			if err != nil {
+				t.Fatalf("sanitizeImage: %v", err)
+			}
+			if info.format != tt.format || info.width != tt.width || info.height != tt.height {
+				t.Errorf("got %s %d×%d, want %s %d×%d", info.format.name, info.width, info.height, tt.format.name, tt.width, tt.height)
+			}
+			if exifOrientation(out, info.format) != 1 {
+				t.Error("orientation left in output")
+			}
+
+			img, _, err := image.Decode(bytes.NewReader(out))
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if b := img.Bounds(); b.Dx() != tt.width || b.Dy() != tt.height {
+				t.Errorf("decoded %d×%d, want %d×%d", b.Dx(), b.Dy(), tt.width, tt.height)
+			}
+			r, g, _, _ := img.At(tt.red.X, tt.red.Y).RGBA()
+			if r < 0xC000 || g > 0x4000 {
+				t.Errorf("pixel at %v is not red", tt.red)
+			}
+		})
+	}
+}
+
+func TestSanitizeImageRejectsMalformed(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   []byte
+		format *imageFormat
+	}{
+		{name: "jpeg", data: []byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF, 0xFF}, format: formatJPEG},
+		{name: "png", data: []byte("\x89PNG\r\n\x1a\n\x00\x00"), format: formatPNG},
+		{name: "webp", data: []byte("RIFF\x00\x00\x00\x00WEBPVP8"), format: formatWebP},
+		{name: "gif", data: []byte("GIF89a"), format: formatGIF},
+		{name: "jpeg with orientation but no image", data: testJPEG(t, jpegSegment(0xE1, append([]byte("Exif\x00\x00"), exifTIFF(binary.BigEndian, 6)...)))[:60], format: formatJPEG},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := sanitizeImage(tt.data, &imageInfo{format: tt.format, width: 32, height: 16}); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+// The fuzz targets feed arbitrary bytes to each stripper: none may panic, and
+// whatever a stripper accepts must come out without metadata and unchanged by
+// a second pass.
+
+func FuzzStripJPEG(f *testing.F) {
+	f.Add(testJPEG(f))
+	f.Add(testJPEG(f,
+		jpegSegment(0xE1, append([]byte("Exif\x00\x00"), exifTIFF(binary.LittleEndian, 6)...)),
+		jpegSegment(0xFE, []byte("comment")),
+	))
+	f.Add([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF, 0xFF})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		exifOrientation(data, formatJPEG)
+		out, err := stripJPEG(data)
+		if err != nil {
+			return
+		}
+		if left := jpegMetadata(out); len(left) > 0 {
+			t.Fatalf("metadata segments left: % X", left)
+		}
+		again, err := stripJPEG(out)
+		if err != nil || !bytes.Equal(again, out) {
+			t.Fatalf("second pass changed the output (err %v)", err)
+		}
+	})
+}
+
+func FuzzStripPNG(f *testing.F) {
+	f.Add(testPNG(f))
+	f.Add(testPNG(f, pngChunk("eXIf", exifTIFF(binary.BigEndian, 6)), pngChunk("tEXt", []byte("a\x00b"))))
+	f.Add([]byte("\x89PNG\r\n\x1a\n"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		exifOrientation(data, formatPNG)
+		out, err := stripPNG(data)
+		if err != nil {
+			return
+		}
+		if left := pngMetadata(out); len(left) > 0 {
+			t.Fatalf("metadata chunks left: %v", left)
+		}
+		again, err := stripPNG(out)
+		if err != nil || !bytes.Equal(again, out) {
+			t.Fatalf("second pass changed the output (err %v)", err)
+		}
+	})
+}
+
+func FuzzStripWebP(f *testing.F) {
+	image := webpChunk("VP8L", []byte{0x2F, 3, 0x40, 0, 0, 0})
+	f.Add(testWebP(image))
+	f.Add(testWebP(vp8x(0x0C), image, webpChunk("EXIF", exifTIFF(binary.LittleEndian, 6)), webpChunk("XMP ", []byte("x"))))
+	f.Add([]byte("RIFF\x00\x00\x00\x00WEBP"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		exifOrientation(data, formatWebP)
+		out, err := stripWebP(data)
+		if err != nil {
+			return
+		}
+		if left, flagged := webpMetadata(out); len(left) > 0 || flagged {
+			t.Fatalf("metadata left: chunks %v, flagged %v", left, flagged)
+		}
+		again, err := stripWebP(out)
+		if err != nil || !bytes.Equal(again, out) {
+			t.Fatalf("second pass changed the output (err %v)", err)
+		}
+	})
+}
+
+func FuzzStripGIF(f *testing.F) {
+	f.Add(testGIF(f))
+	f.Add(testGIF(f, gifExtension(0xFE, []byte("comment")), gifExtension(0xFF, []byte("XMP DataXMP<x/>"))))
+	f.Add([]byte("GIF89a\x04\x00\x02\x00\x87\x00\x00"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		out, err := stripGIF(data)
+		if err != nil {
+			return
+		}
+		again, err := stripGIF(out)
+		if err != nil || !bytes.Equal(again, out) {
+			t.Fatalf("second pass changed the output (err %v)", err)
+		}
+	})
+}
+
+func FuzzAVIFHasMetadata(f *testing.F) {
+	f.Add([]byte("\x00\x00\x00\x18ftypavif\x00\x00\x00\x00avifmif1"))
+	f.Add([]byte("\x00\x00\x00\x01meta\xFF\xFF\xFF\xFF\xFF\xFF\xFF\xFF"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		avifHasMetadata(data)
+	})
+}
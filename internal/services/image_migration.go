@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/schema"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+)
+
+// KeyMigrationReport is the outcome of MigrateImageKeys.
+type KeyMigrationReport struct {
+	// Posts, Authors and Media count the rows rewritten, or that would be in a dry run.
+	Posts   int `json:"posts"`
+	Authors int `json:"authors"`
+	Media   int `json:"media"`
+	// Skipped lists the URLs that do not point at this service's images, such
+	// as external avatars. They are left as they are and served unchanged.
+	Skipped []string `json:"skipped"`
+}
+
+// MigrateImageKeys rewrites the absolute image URLs stored before keys were,
+// in post images and variants, author avatars and media variants, to storage
+// keys. Rows are updated one at a time and keep their update time, so the
+// migration can be rerun after an interruption.
+func (s *ImageService) MigrateImageKeys(ctx context.Context, dryRun bool) (*KeyMigrationReport, error) {
+	report := &KeyMigrationReport{Skipped: []string{}}
+	toKey := func(ref string) (string, bool) {
+		if !isStoredURL(ref) {
+			return ref, false
+		}
+		key, ok := s.keyFromRef(ref)
+		if !ok {
+			report.Skipped = append(report.Skipped, ref)
+			return ref, false
+		}
+		return key, true
+	}
+	migrateVariants := func(variants []schematype.ImageVariant) bool {
+		changed := false
+		for i, v := range variants {
+			if v.Key != "" || v.URL == "" {
+				continue
+			}
+			if key, ok := toKey(v.URL); ok {
+				variants[i].Key, variants[i].URL = key, ""
+				changed = true
+			}
+		}
+		return changed
+	}
+
+	posts, err := s.client.BlogPost.Query().
+		Where(blogpost.ImageNEQ("")).
+		Select(blogpost.FieldImage, blogpost.FieldImageVariants, blogpost.FieldUpdateTime).
+		All(schema.SkipSoftDelete(ctx)) // trashed posts may be restored
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blog post images: %w", err)
+	}
+	for _, p := range posts {
+		image, imageChanged := toKey(p.Image)
+		variantsChanged := migrateVariants(p.ImageVariants)
+		if !imageChanged && !variantsChanged {
+			continue
+		}
+		report.Posts++
+		if dryRun {
+			continue
+		}
+		err := s.client.BlogPost.UpdateOneID(p.ID).
+			SetImage(image).
+			SetImageVariants(p.ImageVariants).
+			SetUpdateTime(p.UpdateTime).
+			Exec(ctx)
+		if err != nil {
+			return report, fmt.Errorf("failed to update blog post %d: %w", p.ID, err)
+		}
+	}
+
+	authors, err := s.client.Author.Query().
+		Where(author.AvatarNEQ("")).
+		Select(author.FieldAvatar, author.FieldUpdateTime).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch author avatars: %w", err)
+	}
+	for _, a := range authors {
+		avatar, changed := toKey(a.Avatar)
+		if !changed {
+			continue
+		}
+		report.Authors++
+		if dryRun {
+			continue
+		}
+		err := s.client.Author.UpdateOneID(a.ID).
+			SetAvatar(avatar).
+			SetUpdateTime(a.UpdateTime).
+			Exec(ctx)
+		if err != nil {
+			return report, fmt.Errorf("failed to update author %d: %w", a.ID, err)
+		}
+	}
+
+	entries, err := s.client.Media.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch media: %w", err)
+	}
+	for _, m := range entries {
+		if !migrateVariants(m.Variants) {
+			continue
+		}
+		report.Media++
+		if dryRun {
+			continue
+		}
+		err := s.client.Media.UpdateOneID(m.ID).
+			SetVariants(m.Variants).
+			SetUpdateTime(m.UpdateTime).
+			Exec(ctx)
+		if err != nil {
+			return report, fmt.Errorf("failed to update media %d: %w", m.ID, err)
+		}
+	}
+	return report, nil
+}
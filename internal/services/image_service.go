@@ -1,58 +1,276 @@
 package services
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
-	"github.com/AdongoJr2/technoprise-backend/internal/utils"
-	"github.com/labstack/echo/v4"
 	"io"
 	"mime/multipart"
-	"os"
-	"path/filepath"
+	"net/url"
+	"strings"
+	"sync/atomic"
 	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/internal/storage"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/sync/singleflight"
 )
 
 type ImageService struct {
-	uploadDir string
-	baseURL   string
+	client     *ent.Client
+	storage    storage.Storage
+	publicPath string
+	limits     ImageLimits
+	variants   []ImageVariantSpec
+	transform  TransformConfig
+	// lastCachePrune is when the transform cache was last pruned, in Unix nanoseconds.
+	lastCachePrune atomic.Int64
+	// transforms deduplicates concurrent cache misses, keyed by cache path.
+	transforms singleflight.Group
+}
+
+// ImageOptions configures an ImageService.
+type ImageOptions struct {
+	// PublicPath is the API path images are served from, such as "/images".
+	// It identifies the URLs that were stored before keys.
+	PublicPath string
+	Limits     ImageLimits
+	// Variants are generated for post images, ordered by width.
+	Variants  []ImageVariantSpec
+	Transform TransformConfig
+}
+
+// NewImageService creates an ImageService storing uploads in store and recording them in client.
+func NewImageService(client *ent.Client, store storage.Storage, opts ImageOptions) *ImageService {
+	return &ImageService{
+		client:     client,
+		storage:    store,
+		publicPath: opts.PublicPath,
+		limits:     opts.Limits,
+		variants:   opts.Variants,
+		transform:  opts.Transform,
+	}
 }
 
-func NewImageService(uploadDir, baseURL string) *ImageService {
-	err := os.MkdirAll(uploadDir, os.ModePerm)
+// UploadImage validates and stores an uploaded image, returning its storage key.
+// The file's type is detected from its contents and its extension normalized
+// to match; non-images wrap ErrUnsupportedMediaType and files over the limits wrap ErrTooLarge.
+// Metadata is stripped and the EXIF orientation applied before storing, and the
+// file is recorded in the media library with meta.
+func (s *ImageService) UploadImage(ctx echo.Context, file *multipart.FileHeader, meta MediaInput) (string, error) {
+	data, info, err := s.readUpload(file)
 	if err != nil {
-		return nil
+		return "", err
 	}
 
-	return &ImageService{
-		uploadDir: uploadDir,
-		baseURL:   baseURL,
+	key, err := s.store(ctx.Request().Context(), data, info)
+	if err != nil {
+		return "", err
 	}
+	if _, err := s.record(ctx.Request().Context(), key, file.Filename, data, info, nil, meta); err != nil {
+		s.deleteKeys(ctx.Request().Context(), key, nil)
+		return "", err
+	}
+	return key, nil
 }
 
-func (s *ImageService) UploadImage(ctx echo.Context, file *multipart.FileHeader) (string, error) {
+// readUpload reads an uploaded file within the byte limit and validates it as an image.
+func (s *ImageService) readUpload(file *multipart.FileHeader) ([]byte, *imageInfo, error) {
+	if s.limits.MaxBytes > 0 && file.Size > s.limits.MaxBytes {
+		return nil, nil, fmt.Errorf("%w: file is %d bytes, the maximum is %d", ErrTooLarge, file.Size, s.limits.MaxBytes)
+	}
+
 	src, err := file.Open()
 	if err != nil {
-		return "", fmt.Errorf("failed to open uploaded file: %w", err)
+		return nil, nil, fmt.Errorf("failed to open uploaded file: %w", err)
 	}
 	defer src.Close()
 
-	// Create a unique filename
-	ext := filepath.Ext(file.Filename)
-	filename := fmt.Sprintf("%d%s", time.Now().UnixNano(), ext)
-	filePath := filepath.Join(s.uploadDir, filename)
+	// Never read more than the limit, whatever the declared size
+	reader := io.Reader(src)
+	if s.limits.MaxBytes > 0 {
+		reader = io.LimitReader(src, s.limits.MaxBytes+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+	if s.limits.MaxBytes > 0 && int64(len(data)) > s.limits.MaxBytes {
+		return nil, nil, fmt.Errorf("%w: the maximum file size is %d bytes", ErrTooLarge, s.limits.MaxBytes)
+	}
+
+	info, err := validateImage(data, s.limits)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sanitizeImage(data, info)
+}
+
+// store saves a validated image under a new unique key with the extension of its detected type.
+func (s *ImageService) store(ctx context.Context, data []byte, info *imageInfo) (string, error) {
+	key := fmt.Sprintf("%d%s", time.Now().UnixNano(), info.format.ext)
+	err := s.storage.Put(ctx, key, bytes.NewReader(data), int64(len(data)), info.format.contentType)
+	if err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// record adds a stored image and its variants to the media library.
+func (s *ImageService) record(ctx context.Context, key, filename string, data []byte, info *imageInfo, variants []schematype.ImageVariant, meta MediaInput) (*ent.Media, error) {
+	mediaCreate := s.client.Media.Create().
+		SetKey(key).
+		SetFormat(info.format.name).
+		SetMimeType(info.format.contentType).
+		SetWidth(info.width).
+		SetHeight(info.height).
+		SetSize(int64(len(data))).
+		SetNillableAltText(meta.AltText).
+		SetNillableCaption(meta.Caption).
+		SetNillableUploaderID(meta.UploaderID).
+		SetPostUpload(meta.PostUpload)
+
+	if filename != "" {
+		mediaCreate = mediaCreate.SetFilename(filename)
+	}
+	if variants != nil {
+		mediaCreate = mediaCreate.SetVariants(variants)
+	}
+
+	m, err := mediaCreate.Save(ctx)
+	if err != nil {
+		if ent.IsValidationError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		return nil, fmt.Errorf("failed to record image: %w", err)
+	}
+	return m, nil
+}
+
+// URL returns the public URL of an image reference: a storage key, or a URL
+// stored before keys were, which is returned unchanged. Empty references stay empty.
+// Keys served through the API without a configured public URL are made absolute
+// with the request's public base URL, when ctx carries one.
+func (s *ImageService) URL(ctx context.Context, ref string) string {
+	if ref == "" || isStoredURL(ref) {
+		return ref
+	}
+	u := s.storage.URL(ref)
+	if strings.HasPrefix(u, "/") {
+		return utils.PublicBaseURLFrom(ctx) + u
+	}
+	return u
+}
+
+// DeleteImage removes the stored file referenced by ref, a key or legacy URL, and its Media record.
+// URLs that do not point at this service's storage are ignored.
+func (s *ImageService) DeleteImage(ctx context.Context, ref string) error {
+	key, ok := s.keyFromRef(ref)
+	if !ok {
+		return nil
+	}
+	if _, err := s.client.Media.Delete().Where(media.Key(key)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete image record: %w", err)
+	}
+	s.purgeTransforms(key)
+	return s.storage.Delete(ctx, key)
+}
+
+// keyFromRef returns the storage key of an image reference. Legacy URLs are
+// recognized by the storage's current base URL or, whatever their host, by the
+// API's image path.
+func (s *ImageService) keyFromRef(ref string) (string, bool) {
+	if ref == "" {
+		return "", false
+	}
+	if !isStoredURL(ref) {
+		return ref, true
+	}
 
-	// Create destination file
-	dst, err := os.Create(filePath)
+	if key, ok := strings.CutPrefix(ref, s.storage.URL("")); ok && key != "" {
+		return key, true
+	}
+	if s.publicPath == "" {
+		return "", false
+	}
+	parsed, err := url.Parse(ref)
 	if err != nil {
-		return "", fmt.Errorf("failed to create file: %w", err)
+		return "", false
 	}
-	defer dst.Close()
+	key, ok := strings.CutPrefix(parsed.Path, strings.TrimSuffix(s.publicPath, "/")+"/")
+	if !ok || key == "" {
+		return "", false
+	}
+	return key, true
+}
 
-	// Copy file content
-	if _, err = io.Copy(dst, src); err != nil {
-		return "", fmt.Errorf("failed to save file: %w", err)
+// isStoredURL reports whether an image reference is a URL, as stored before keys were, rather than a key.
+func isStoredURL(ref string) bool {
+	return strings.HasPrefix(ref, "/") || strings.Contains(ref, "://")
+}
+
+// OpenImage opens the stored image under key for serving. The caller must close it.
+// Returns ErrNotFound when there is no such image.
+func (s *ImageService) OpenImage(ctx context.Context, key string) (io.ReadSeekCloser, storage.ObjectInfo, error) {
+	obj, info, err := s.storage.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotExist) || errors.Is(err, storage.ErrInvalidKey) {
+			return nil, info, fmt.Errorf("image '%s' %w", key, ErrNotFound)
+		}
+		return nil, info, err
+	}
+	return obj, info, nil
+}
+
+// resolveVariants replaces the keys of variants with their public URLs.
+func (s *ImageService) resolveVariants(ctx context.Context, variants []schematype.ImageVariant) {
+	for i, v := range variants {
+		if v.Key != "" {
+			variants[i].URL, variants[i].Key = s.URL(ctx, v.Key), ""
+		}
+	}
+}
+
+// resolvePosts replaces the image keys of posts, and of their bylines' avatars, with public URLs.
+func (s *ImageService) resolvePosts(ctx context.Context, posts ...*ent.BlogPost) {
+	for _, p := range posts {
+		p.Image = s.URL(ctx, p.Image)
+		s.resolveVariants(ctx, p.ImageVariants)
+		if p.Edges.Byline != nil {
+			s.resolveAuthors(ctx, p.Edges.Byline)
+		}
 	}
+}
+
+// resolveAuthors replaces the avatar keys of authors with public URLs.
+func (s *ImageService) resolveAuthors(ctx context.Context, authors ...*ent.Author) {
+	for _, a := range authors {
+		a.Avatar = s.URL(ctx, a.Avatar)
+	}
+}
+
+// MediaImage returns the image of a media library entry for use on a post.
+// Returns ErrNotFound when there is no such entry.
+func (s *ImageService) MediaImage(ctx context.Context, id int) (*ResponsiveImage, error) {
+	m, err := s.client.Media.Query().
+		Where(media.ID(id)).
+		Select(media.FieldKey, media.FieldVariants).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("media %d %w", id, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to retrieve media: %w", err)
+	}
+	return &ResponsiveImage{Key: m.Key, Variants: m.Variants, MediaID: m.ID}, nil
+}
 
-	// Return accessible URL
-	publicHost := utils.GetPublicHost(ctx)
-	return fmt.Sprintf("%s%s/%s", publicHost, s.baseURL, filename), nil
+// withMediaSummary limits an eager-loaded media edge to the image's description, format and dimensions.
+func withMediaSummary(q *ent.MediaQuery) {
+	q.Select(media.FieldAltText, media.FieldFormat, media.FieldWidth, media.FieldHeight)
 }
@@ -0,0 +1,441 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/storage"
+)
+
+// Fit modes of image transforms.
+const (
+	// FitContain scales the image to fit within the requested box, keeping its aspect ratio.
+	FitContain = "contain"
+	// FitCover scales and center-crops the image to fill the requested box.
+	FitCover = "cover"
+	// FitFill stretches the image to the requested box.
+	FitFill = "fill"
+)
+
+// TransformConfig configures on-the-fly image transforms.
+type TransformConfig struct {
+	// CacheDir holds transformed images. Each replica keeps its own cache.
+	CacheDir string
+	// CacheMaxBytes caps the total size of the cache, evicting the oldest
+	// transforms first; 0 means no cap.
+	CacheMaxBytes int64
+	// CacheMaxAge evicts transforms generated longer ago than this; 0 keeps
+	// them until the size cap or their source image's deletion.
+	CacheMaxAge time.Duration
+	// Sizes lists the widths and heights that may be requested.
+	Sizes []int
+	// Qualities lists the JPEG qualities that may be requested.
+	Qualities []int
+}
+
+// TransformOptions describes a transform. Zero values mean "unset".
+type TransformOptions struct {
+	Width   int
+	Height  int
+	Fit     string
+	Format  string
+	Quality int
+}
+
+// TransformedImage is a transformed image opened for serving.
+type TransformedImage struct {
+	io.ReadSeekCloser
+	Info storage.ObjectInfo
+	// ETag identifies the transformed content.
+	ETag string
+}
+
+// outputFormats maps the format parameter of transforms to encodable formats.
+var outputFormats = map[string]*imageFormat{
+	"jpeg": formatJPEG,
+	"jpg":  formatJPEG,
+	"png":  formatPNG,
+}
+
+// ValidateTransform checks opts against the configured whitelist and returns the
+// rejected parameters by name. Options are usable when the result is empty.
+func (s *ImageService) ValidateTransform(opts TransformOptions) map[string]string {
+	errs := map[string]string{}
+	if opts.Width != 0 && !slices.Contains(s.transform.Sizes, opts.Width) {
+		errs["w"] = fmt.Sprintf("must be one of %v", s.transform.Sizes)
+	}
+	if opts.Height != 0 && !slices.Contains(s.transform.Sizes, opts.Height) {
+		errs["h"] = fmt.Sprintf("must be one of %v", s.transform.Sizes)
+	}
+	switch opts.Fit {
+	case "", FitContain, FitCover, FitFill:
+	default:
+		errs["fit"] = "must be contain, cover or fill"
+	}
+	if opts.Format != "" && outputFormats[opts.Format] == nil {
+		errs["format"] = "must be jpeg or png"
+	}
+	if opts.Quality != 0 && !slices.Contains(s.transform.Qualities, opts.Quality) {
+		errs["q"] = fmt.Sprintf("must be one of %v", s.transform.Qualities)
+	}
+	return errs
+}
+
+// TransformImage returns the stored image under key transformed by opts, which
+// must have passed ValidateTransform. Results are cached on disk by key and
+// options, and regenerated when the source image changes.
+// Returns ErrNotFound for missing images and ErrUnsupportedMediaType for
+// formats that cannot be decoded.
+func (s *ImageService) TransformImage(ctx context.Context, key string, opts TransformOptions) (*TransformedImage, error) {
+	if len(s.ValidateTransform(opts)) > 0 {
+		return nil, fmt.Errorf("%w: transform parameters are not allowed", ErrInvalidInput)
+	}
+
+	src, info, err := s.OpenImage(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	s.schedulePruneCache()
+
+	cacheKey := transformCacheKey(key, opts)
+	etag := fmt.Sprintf(`"%s-%x"`, cacheKey[:16], info.ModTime.UnixNano())
+	cachePath := filepath.Join(s.transformCacheDir(key), cacheKey)
+
+	if cached, err := openCached(cachePath, info); err == nil {
+		return &TransformedImage{ReadSeekCloser: cached.file, Info: cached.info, ETag: etag}, nil
+	}
+
+	// Concurrent misses for the same transform wait for one decode instead of
+	// each decoding the image
+	_, err, _ = s.transforms.Do(cachePath, func() (any, error) {
+		if cached, err := openCached(cachePath, info); err == nil {
+			// Written by a request that finished in the meantime
+			cached.file.Close()
+			return nil, nil
+		}
+		data, err := transform(src, info, opts, s.limits)
+		if err != nil {
+			return nil, err
+		}
+		return nil, writeCache(cachePath, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cached, err := openCached(cachePath, info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cached image: %w", err)
+	}
+	return &TransformedImage{ReadSeekCloser: cached.file, Info: cached.info, ETag: etag}, nil
+}
+
+// transformCacheKey returns the hex cache key of key transformed by opts.
+func transformCacheKey(key string, opts TransformOptions) string {
+	format := outputFormats[opts.Format]
+	name := ""
+	if format != nil {
+		name = format.name
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%d\x00%d\x00%s\x00%s\x00%d",
+		key, opts.Width, opts.Height, opts.Fit, name, opts.Quality)))
+	return hex.EncodeToString(sum[:])
+}
+
+// transformCacheDir returns the directory holding the transforms of key. They
+// share one, so deleting the image can drop them all.
+func (s *ImageService) transformCacheDir(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(s.transform.CacheDir, name[:2], name)
+}
+
+// purgeTransforms deletes the cached transforms of key. Failures are only
+// logged, as the image itself is gone by then.
+func (s *ImageService) purgeTransforms(key string) {
+	if s.transform.CacheDir == "" {
+		return
+	}
+	if err := os.RemoveAll(s.transformCacheDir(key)); err != nil {
+		log.Printf("Error deleting cached transforms of '%s': %v", key, err)
+	}
+}
+
+// cachePruneInterval is the least time between two prunes of the transform cache.
+const cachePruneInterval = time.Minute
+
+// schedulePruneCache prunes the transform cache in the background unless it
+// was pruned within cachePruneInterval.
+func (s *ImageService) schedulePruneCache() {
+	if s.transform.CacheMaxBytes == 0 && s.transform.CacheMaxAge == 0 {
+		return
+	}
+	now := time.Now().UnixNano()
+	last := s.lastCachePrune.Load()
+	if now-last < int64(cachePruneInterval) || !s.lastCachePrune.CompareAndSwap(last, now) {
+		return
+	}
+	go func() {
+		removed, freed, err := pruneTransformCache(s.transform, time.Now())
+		if err != nil {
+			log.Printf("Error pruning image transform cache: %v", err)
+		}
+		if removed > 0 {
+			log.Printf("Pruned %d cached image transforms (%d bytes)", removed, freed)
+		}
+	}()
+}
+
+// cacheFile is a file found in the transform cache.
+type cacheFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// pruneTransformCache deletes the files of cfg.CacheDir generated longer ago
+// than cfg.CacheMaxAge, then the oldest ones until the rest fit
+// cfg.CacheMaxBytes, and finally abandoned temporary files and the
+// directories left empty. It returns the
+// number and total size of the files deleted.
+func pruneTransformCache(cfg TransformConfig, now time.Time) (int, int64, error) {
+	var files, abandoned []cacheFile
+	var dirs []string
+	err := filepath.WalkDir(cfg.CacheDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			// Deleted since it was listed
+			return nil
+		}
+		if d.IsDir() {
+			// A new directory may be about to receive a transform
+			if path != cfg.CacheDir && now.Sub(info.ModTime()) >= time.Hour {
+				dirs = append(dirs, path)
+			}
+			return nil
+		}
+		file := cacheFile{path: path, size: info.Size(), modTime: info.ModTime()}
+		if strings.HasPrefix(d.Name(), ".transform-") {
+			// Files being written are spared until they are old enough to be abandoned ones
+			if now.Sub(file.modTime) >= time.Hour {
+				abandoned = append(abandoned, file)
+			}
+			return nil
+		}
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list cached transforms: %w", err)
+	}
+
+	// Newest first, so the files past the age or size cap form the tail
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.After(files[j].modTime) })
+	var kept int64
+	keep := len(files)
+	for i, f := range files {
+		if cfg.CacheMaxAge > 0 && now.Sub(f.modTime) > cfg.CacheMaxAge ||
+			cfg.CacheMaxBytes > 0 && kept+f.size > cfg.CacheMaxBytes {
+			keep = i
+			break
+		}
+		kept += f.size
+	}
+
+	removed, freed := 0, int64(0)
+	var errs []error
+	for _, f := range append(files[keep:], abandoned...) {
+		if err := os.Remove(f.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, err)
+			continue
+		}
+		removed++
+		freed += f.size
+	}
+
+	// Deepest first, so a directory is emptied of subdirectories before it is tried
+	for i := len(dirs) - 1; i >= 0; i-- {
+		// Fails, as intended, for directories still holding files
+		os.Remove(dirs[i])
+	}
+	return removed, freed, errors.Join(errs...)
+}
+
+// cachedImage is an open cache file.
+type cachedImage struct {
+	file *os.File
+	info storage.ObjectInfo
+}
+
+// openCached opens the cache file at path unless it is older than the source image.
+func openCached(path string, source storage.ObjectInfo) (*cachedImage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if stat.ModTime().Before(source.ModTime) {
+		f.Close()
+		return nil, errors.New("cached image is stale")
+	}
+
+	// The content type is sniffed from the cached bytes so hits need no metadata file
+	format := formatJPEG
+	header := make([]byte, 16)
+	if n, _ := io.ReadFull(f, header); sniffImage(header[:n]) == formatPNG {
+		format = formatPNG
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &cachedImage{
+		file: f,
+		info: storage.ObjectInfo{
+			Size:        stat.Size(),
+			ContentType: format.contentType,
+			// Served as last modified when the source was, since the output only depends on it
+			ModTime: source.ModTime,
+		},
+	}, nil
+}
+
+// writeCache atomically writes data to path.
+func writeCache(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".transform-*")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	return nil
+}
+
+// transform decodes src, resizes it according to opts and re-encodes it. The
+// stored file is checked against limits before it is decoded, as files stored
+// before uploads were validated may be decompression bombs.
+func transform(src io.Reader, info storage.ObjectInfo, opts TransformOptions, limits ImageLimits) ([]byte, error) {
+	if limits.MaxBytes > 0 && info.Size > limits.MaxBytes {
+		return nil, fmt.Errorf("%w: image is %d bytes, too large to transform", ErrUnsupportedMediaType, info.Size)
+	}
+	reader := src
+	if limits.MaxBytes > 0 {
+		reader = io.LimitReader(src, limits.MaxBytes+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if limits.MaxBytes > 0 && int64(len(data)) > limits.MaxBytes {
+		return nil, fmt.Errorf("%w: image is too large to transform", ErrUnsupportedMediaType)
+	}
+	if _, err := validateImage(data, limits); err != nil {
+		if errors.Is(err, ErrTooLarge) {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupportedMediaType, err)
+		}
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: image cannot be transformed", ErrUnsupportedMediaType)
+	}
+
+	crop, width, height := transformGeometry(img.Bounds(), opts)
+	quality := opts.Quality
+	if quality == 0 {
+		quality = variantJPEGQuality
+	}
+
+	out, _, err := encodeImage(scaleImage(img, crop, width, height), outputFormats[opts.Format], quality)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return out, nil
+}
+
+// transformGeometry returns the source rectangle to use and the output size for
+// opts. Images are never enlarged, except by FitFill, which stretches to the exact box.
+func transformGeometry(bounds image.Rectangle, opts TransformOptions) (image.Rectangle, int, int) {
+	sw, sh := bounds.Dx(), bounds.Dy()
+	w, h := opts.Width, opts.Height
+
+	switch {
+	case w == 0 && h == 0:
+		return bounds, sw, sh
+	case h == 0:
+		w = min(w, sw)
+		return bounds, w, max(1, sh*w/sw)
+	case w == 0:
+		h = min(h, sh)
+		return bounds, max(1, sw*h/sh), h
+	}
+
+	switch opts.Fit {
+	case FitFill:
+		return bounds, w, h
+	case FitCover:
+		// Crop the largest centered rectangle with the box's aspect ratio, then scale it down
+		cw, ch := sw, sw*h/w
+		if ch > sh {
+			cw, ch = sh*w/h, sh
+		}
+		// A sliver of an image, such as 1000×1 cropped to a square, rounds to
+		// nothing on its short side
+		cw, ch = max(1, cw), max(1, ch)
+		x, y := bounds.Min.X+(sw-cw)/2, bounds.Min.Y+(sh-ch)/2
+		crop := image.Rect(x, y, x+cw, y+ch)
+		if cw <= w {
+			return crop, cw, ch
+		}
+		return crop, w, h
+	default:
+		// Scale down by whichever side is more constrained
+		if sw*h > sh*w {
+			w = min(w, sw)
+			return bounds, w, max(1, sh*w/sw)
+		}
+		h = min(h, sh)
+		return bounds, max(1, sw*h/sh), h
+	}
+}
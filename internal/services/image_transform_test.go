@@ -0,0 +1,360 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/internal/storage"
+)
+
+func TestTransformGeometry(t *testing.T) {
+	tests := []struct {
+		name       string
+		bounds     image.Rectangle
+		opts       TransformOptions
+		wantCrop   image.Rectangle
+		wantWidth  int
+		wantHeight int
+	}{
+		{
+			name:     "no size keeps the image",
+			bounds:   image.Rect(0, 0, 800, 600),
+			wantCrop: image.Rect(0, 0, 800, 600), wantWidth: 800, wantHeight: 600,
+		},
+		{
+			name:     "width only",
+			bounds:   image.Rect(0, 0, 800, 600),
+			opts:     TransformOptions{Width: 400},
+			wantCrop: image.Rect(0, 0, 800, 600), wantWidth: 400, wantHeight: 300,
+		},
+		{
+			name:     "width only never enlarges",
+			bounds:   image.Rect(0, 0, 200, 100),
+			opts:     TransformOptions{Width: 400},
+			wantCrop: image.Rect(0, 0, 200, 100), wantWidth: 200, wantHeight: 100,
+		},
+		{
+			name:     "height only of a sliver",
+			bounds:   image.Rect(0, 0, 1, 1000),
+			opts:     TransformOptions{Height: 64},
+			wantCrop: image.Rect(0, 0, 1, 1000), wantWidth: 1, wantHeight: 64,
+		},
+		{
+			name:     "contain",
+			bounds:   image.Rect(0, 0, 800, 600),
+			opts:     TransformOptions{Width: 400, Height: 400},
+			wantCrop: image.Rect(0, 0, 800, 600), wantWidth: 400, wantHeight: 300,
+		},
+		{
+			name:     "fill stretches",
+			bounds:   image.Rect(0, 0, 800, 600),
+			opts:     TransformOptions{Width: 100, Height: 400, Fit: FitFill},
+			wantCrop: image.Rect(0, 0, 800, 600), wantWidth: 100, wantHeight: 400,
+		},
+		{
+			name:     "cover crops the center",
+			bounds:   image.Rect(0, 0, 800, 600),
+			opts:     TransformOptions{Width: 400, Height: 400, Fit: FitCover},
+			wantCrop: image.Rect(100, 0, 700, 600), wantWidth: 400, wantHeight: 400,
+		},
+		{
+			name:     "cover of a small image is not enlarged",
+			bounds:   image.Rect(0, 0, 200, 100),
+			opts:     TransformOptions{Width: 400, Height: 400, Fit: FitCover},
+			wantCrop: image.Rect(50, 0, 150, 100), wantWidth: 100, wantHeight: 100,
+		},
+		{
+			name:     "cover of a wide sliver",
+			bounds:   image.Rect(0, 0, 1000, 1),
+			opts:     TransformOptions{Width: 64, Height: 128, Fit: FitCover},
+			wantCrop: image.Rect(499, 0, 500, 1), wantWidth: 1, wantHeight: 1,
+		},
+		{
+			name:     "cover of a tall sliver",
+			bounds:   image.Rect(0, 0, 1, 1000),
+			opts:     TransformOptions{Width: 128, Height: 64, Fit: FitCover},
+			wantCrop: image.Rect(0, 499, 1, 500), wantWidth: 1, wantHeight: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crop, width, height := transformGeometry(tt.bounds, tt.opts)
+			if crop != tt.wantCrop || width != tt.wantWidth || height != tt.wantHeight {
+				t.Errorf("transformGeometry() = %v, %d×%d, want %v, %d×%d",
+					crop, width, height, tt.wantCrop, tt.wantWidth, tt.wantHeight)
+			}
+			if crop.Empty() || !crop.In(tt.bounds) {
+				t.Errorf("transformGeometry() crop %v is empty or outside %v", crop, tt.bounds)
+			}
+			// Scaling to the result must not panic
+			scaleImage(image.NewNRGBA(tt.bounds), crop, width, height)
+		})
+	}
+}
+
+func TestPruneTransformCache(t *testing.T) {
+	now := time.Now()
+	// Each file is 10 bytes; age is how long ago it was generated. The file
+	// being written is spared and the abandoned one always removed.
+	files := []struct {
+		path string
+		age  time.Duration
+	}{
+		{"aa/aaaa/new", time.Minute},
+		{"aa/aaaa/recent", time.Hour},
+		{"bb/bbbb/day", 24 * time.Hour},
+		{"cc/cccc/week", 7 * 24 * time.Hour},
+		{"cc/cccc/.transform-123", time.Minute},
+		{"dd/dddd/.transform-456", 2 * time.Hour},
+	}
+
+	tests := []struct {
+		name        string
+		maxBytes    int64
+		maxAge      time.Duration
+		wantKept    []string
+		wantRemoved int
+	}{
+		{
+			name:        "no caps keep everything but abandoned writes",
+			wantKept:    []string{"aa/aaaa/new", "aa/aaaa/recent", "bb/bbbb/day", "cc/cccc/week", "cc/cccc/.transform-123"},
+			wantRemoved: 1,
+		},
+		{
+			name:        "age cap",
+			maxAge:      2 * 24 * time.Hour,
+			wantKept:    []string{"aa/aaaa/new", "aa/aaaa/recent", "bb/bbbb/day", "cc/cccc/.transform-123"},
+			wantRemoved: 2,
+		},
+		{
+			name:        "size cap evicts the oldest",
+			maxBytes:    25,
+			wantKept:    []string{"aa/aaaa/new", "aa/aaaa/recent", "cc/cccc/.transform-123"},
+			wantRemoved: 3,
+		},
+		{
+			name:        "both caps",
+			maxBytes:    100,
+			maxAge:      30 * time.Minute,
+			wantKept:    []string{"aa/aaaa/new", "cc/cccc/.transform-123"},
+			wantRemoved: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, f := range files {
+				path := filepath.Join(dir, f.path)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte("0123456789"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Chtimes(path, now.Add(-f.age), now.Add(-f.age)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// Directories only hold old transforms; new ones are left alone
+			for _, d := range []string{"aa", "aa/aaaa", "bb", "bb/bbbb", "cc", "cc/cccc", "dd", "dd/dddd"} {
+				old := now.Add(-48 * time.Hour)
+				if err := os.Chtimes(filepath.Join(dir, d), old, old); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cfg := TransformConfig{CacheDir: dir, CacheMaxBytes: tt.maxBytes, CacheMaxAge: tt.maxAge}
+			removed, freed, err := pruneTransformCache(cfg, now)
+			if err != nil {
+				t.Fatalf("pruneTransformCache() error = %v", err)
+			}
+			if removed != tt.wantRemoved || freed != int64(10*tt.wantRemoved) {
+				t.Errorf("pruneTransformCache() = %d files, %d bytes, want %d files, %d bytes",
+					removed, freed, tt.wantRemoved, 10*tt.wantRemoved)
+			}
+
+			var kept []string
+			filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+				if err == nil && !d.IsDir() {
+					rel, _ := filepath.Rel(dir, path)
+					kept = append(kept, filepath.ToSlash(rel))
+				}
+				return nil
+			})
+			if len(kept) != len(tt.wantKept) {
+				t.Fatalf("kept %q, want %q", kept, tt.wantKept)
+			}
+			for _, want := range tt.wantKept {
+				if _, err := os.Stat(filepath.Join(dir, want)); err != nil {
+					t.Errorf("%s was removed: %v", want, err)
+				}
+			}
+			// Directories left empty are removed
+			if _, err := os.Stat(filepath.Join(dir, "dd")); !os.IsNotExist(err) {
+				t.Errorf("empty directory dd was kept: %v", err)
+			}
+		})
+	}
+}
+
+func TestPurgeTransforms(t *testing.T) {
+	s := &ImageService{transform: TransformConfig{CacheDir: t.TempDir()}}
+	for _, key := range []string{"a.jpg", "b.jpg"} {
+		path := filepath.Join(s.transformCacheDir(key), transformCacheKey(key, TransformOptions{Width: 64}))
+		if err := writeCache(path, []byte("cached")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s.purgeTransforms("a.jpg")
+
+	if _, err := os.Stat(s.transformCacheDir("a.jpg")); !os.IsNotExist(err) {
+		t.Errorf("transforms of a.jpg were kept: %v", err)
+	}
+	if _, err := os.Stat(s.transformCacheDir("b.jpg")); err != nil {
+		t.Errorf("transforms of b.jpg were removed: %v", err)
+	}
+}
+
+// countingStorage counts the stored objects read through it.
+type countingStorage struct {
+	storage.Storage
+	reads atomic.Int32
+}
+
+func (s *countingStorage) Get(ctx context.Context, key string) (io.ReadSeekCloser, storage.ObjectInfo, error) {
+	obj, info, err := s.Storage.Get(ctx, key)
+	if err != nil {
+		return nil, info, err
+	}
+	return &countingReader{ReadSeekCloser: obj, reads: &s.reads}, info, nil
+}
+
+// countingReader counts itself as read on its first Read.
+type countingReader struct {
+	io.ReadSeekCloser
+	reads   *atomic.Int32
+	counted bool
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	if !r.counted {
+		r.counted = true
+		r.reads.Add(1)
+	}
+	return r.ReadSeekCloser.Read(p)
+}
+
+// newTransformService returns an ImageService storing files in a temporary
+// directory, with that storage and the image limits.
+func newTransformService(t *testing.T, limits ImageLimits) (*ImageService, *countingStorage) {
+	t.Helper()
+	local, err := storage.NewLocal(t.TempDir(), "http://localhost/images")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &countingStorage{Storage: local}
+	s := NewImageService(nil, store, ImageOptions{
+		Limits: limits,
+		Transform: TransformConfig{
+			CacheDir:  t.TempDir(),
+			Sizes:     []int{16, 64},
+			Qualities: []int{80},
+		},
+	})
+	return s, store
+}
+
+func TestTransformImageRejectsDecompressionBombs(t *testing.T) {
+	// A PNG claiming 50000×50000 pixels in a few bytes, as a file stored
+	// before uploads were validated could
+	ihdr := binary.BigEndian.AppendUint32(nil, 50000)
+	ihdr = binary.BigEndian.AppendUint32(ihdr, 50000)
+	ihdr = append(ihdr, 8, 6, 0, 0, 0)
+	bomb := append([]byte("\x89PNG\r\n\x1a\n"), pngChunk("IHDR", ihdr)...)
+	bomb = append(bomb, pngChunk("IDAT", []byte{0x78, 0x9C, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01})...)
+	bomb = append(bomb, pngChunk("IEND", nil)...)
+
+	s, store := newTransformService(t, ImageLimits{MaxBytes: 1 << 20, MaxDimension: 10000, MaxPixels: 40_000_000})
+	ctx := context.Background()
+	if err := store.Put(ctx, "bomb.png", bytes.NewReader(bomb), int64(len(bomb)), "image/png"); err != nil {
+		t.Fatal(err)
+	}
+	big := bytes.Repeat([]byte{0}, 2<<20)
+	if err := store.Put(ctx, "big.png", bytes.NewReader(big), int64(len(big)), "image/png"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"bomb.png", "big.png"} {
+		t.Run(key, func(t *testing.T) {
+			img, err := s.TransformImage(ctx, key, TransformOptions{Width: 64})
+			if err == nil {
+				img.Close()
+				t.Fatal("expected an error")
+			}
+			if !errors.Is(err, ErrUnsupportedMediaType) {
+				t.Errorf("TransformImage() error = %v, want ErrUnsupportedMediaType", err)
+			}
+		})
+	}
+}
+
+func TestTransformImageDecodesConcurrentMissesOnce(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage()); err != nil {
+		t.Fatal(err)
+	}
+	s, store := newTransformService(t, ImageLimits{MaxBytes: 1 << 20})
+	ctx := context.Background()
+	if err := store.Put(ctx, "photo.png", bytes.NewReader(buf.Bytes()), int64(buf.Len()), "image/png"); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			img, err := s.TransformImage(ctx, "photo.png", TransformOptions{Width: 16})
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer img.Close()
+			decoded, _, err := image.Decode(img)
+			if err == nil && decoded.Bounds().Dx() != 16 {
+				err = fmt.Errorf("transformed image is %v", decoded.Bounds())
+			}
+			if err != nil {
+				errs <- err
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	if n := store.reads.Load(); n != 1 {
+		t.Errorf("source image read %d times, want once", n)
+	}
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"golang.org/x/image/webp"
+)
+
+// ErrUnsupportedMediaType is wrapped by upload errors for files that are not a supported image.
+var ErrUnsupportedMediaType = errors.New("unsupported media type")
+
+// ErrTooLarge is wrapped by upload errors for files exceeding the configured size limits.
+var ErrTooLarge = errors.New("upload too large")
+
+// ImageLimits bounds the uploads ImageService accepts. Zero values disable a limit.
+type ImageLimits struct {
+	// MaxBytes is the largest accepted file size.
+	MaxBytes int64
+	// MaxDimension is the largest accepted width or height in pixels.
+	MaxDimension int
+	// MaxPixels is the largest accepted width × height. Images are checked
+	// against it before they are ever decoded, which guards against
+	// decompression bombs: tiny files that declare huge canvases.
+	MaxPixels int64
+}
+
+// imageFormat is an image type accepted for upload.
+type imageFormat struct {
+	name        string
+	ext         string
+	contentType string
+	// decodeConfig reads the dimensions from the image header without decoding pixels.
+	decodeConfig func(data []byte) (image.Config, error)
+}
+
+var (
+	formatJPEG = &imageFormat{name: "jpeg", ext: ".jpg", contentType: "image/jpeg", decodeConfig: readerConfig(jpeg.DecodeConfig)}
+	formatPNG  = &imageFormat{name: "png", ext: ".png", contentType: "image/png", decodeConfig: readerConfig(png.DecodeConfig)}
+	formatGIF  = &imageFormat{name: "gif", ext: ".gif", contentType: "image/gif", decodeConfig: readerConfig(gif.DecodeConfig)}
+	formatWebP = &imageFormat{name: "webp", ext: ".webp", contentType: "image/webp", decodeConfig: readerConfig(webp.DecodeConfig)}
+	formatAVIF = &imageFormat{name: "avif", ext: ".avif", contentType: "image/avif", decodeConfig: avifConfig}
+)
+
+// imageInfo describes a validated upload.
+type imageInfo struct {
+	format *imageFormat
+	width  int
+	height int
+}
+
+// validateImage identifies data by its magic bytes and checks its dimensions against limits.
+// The client-supplied filename and content type are never trusted.
+func validateImage(data []byte, limits ImageLimits) (*imageInfo, error) {
+	format := sniffImage(data)
+	if format == nil {
+		return nil, fmt.Errorf("%w: only JPEG, PNG, WebP, GIF and AVIF images are accepted", ErrUnsupportedMediaType)
+	}
+
+	cfg, err := format.decodeConfig(data)
+	if err != nil || cfg.Width < 1 || cfg.Height < 1 {
+		return nil, fmt.Errorf("%w: malformed %s image", ErrUnsupportedMediaType, format.name)
+	}
+
+	if limits.MaxDimension > 0 && (cfg.Width > limits.MaxDimension || cfg.Height > limits.MaxDimension) {
+		return nil, fmt.Errorf("%w: image is %dx%d pixels, the maximum is %d pixels per side",
+			ErrTooLarge, cfg.Width, cfg.Height, limits.MaxDimension)
+	}
+	if limits.MaxPixels > 0 && int64(cfg.Width)*int64(cfg.Height) > limits.MaxPixels {
+		return nil, fmt.Errorf("%w: image has %d pixels, the maximum is %d",
+			ErrTooLarge, int64(cfg.Width)*int64(cfg.Height), limits.MaxPixels)
+	}
+
+	return &imageInfo{format: format, width: cfg.Width, height: cfg.Height}, nil
+}
+
+// sniffImage returns the format identified by the magic bytes at the start of data, or nil.
+func sniffImage(data []byte) *imageFormat {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		return formatJPEG
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return formatPNG
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return formatGIF
+	case len(data) >= 12 && string(data[0:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return formatWebP
+	case isAVIF(data):
+		return formatAVIF
+	}
+	return nil
+}
+
+// isAVIF reports whether data starts with an ISOBMFF 'ftyp' box listing an AVIF brand.
+func isAVIF(data []byte) bool {
+	if len(data) < 16 || string(data[4:8]) != "ftyp" {
+		return false
+	}
+	size := int(binary.BigEndian.Uint32(data[0:4]))
+	if size < 16 || size > len(data) {
+		return false
+	}
+	// Major brand at 8, minor version at 12, compatible brands from 16
+	for i := 8; i+4 <= size; i += 4 {
+		if i == 12 {
+			continue
+		}
+		if brand := string(data[i : i+4]); brand == "avif" || brand == "avis" {
+			return true
+		}
+	}
+	return false
+}
+
+// readerConfig adapts a standard library DecodeConfig function to imageFormat.decodeConfig.
+func readerConfig(decode func(r io.Reader) (image.Config, error)) func([]byte) (image.Config, error) {
+	return func(data []byte) (image.Config, error) {
+		return decode(bytes.NewReader(data))
+	}
+}
+
+// avifConfig reads the dimensions of an AVIF image from the image spatial extent
+// ('ispe') properties in meta/iprp/ipco. The largest extent is used, so grid
+// images are checked against their full canvas.
+func avifConfig(data []byte) (image.Config, error) {
+	meta := findBox(data, "meta")
+	if len(meta) < 4 {
+		return image.Config{}, errors.New("avif: missing meta box")
+	}
+	// meta is a full box: skip version and flags
+	ipco := findBox(findBox(meta[4:], "iprp"), "ipco")
+	if ipco == nil {
+		return image.Config{}, errors.New("avif: missing item properties")
+	}
+
+	var cfg image.Config
+	walkBoxes(ipco, func(typ string, payload []byte) bool {
+		// ispe is a full box: version and flags, then width and height
+		if typ == "ispe" && len(payload) >= 12 {
+			w := int(binary.BigEndian.Uint32(payload[4:8]))
+			h := int(binary.BigEndian.Uint32(payload[8:12]))
+			if w*h > cfg.Width*cfg.Height {
+				cfg.Width, cfg.Height = w, h
+			}
+		}
+		return true
+	})
+	if cfg.Width == 0 {
+		return image.Config{}, errors.New("avif: missing image extent")
+	}
+	return cfg, nil
+}
+
+// findBox returns the payload of the first ISOBMFF box of type typ in data, or nil.
+func findBox(data []byte, typ string) []byte {
+	var found []byte
+	walkBoxes(data, func(t string, payload []byte) bool {
+		if t == typ {
+			found = payload
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// walkBoxes calls fn with the type and payload of each top-level ISOBMFF box
+// in data until fn returns false. Truncated boxes end the walk.
+func walkBoxes(data []byte, fn func(typ string, payload []byte) bool) {
+	for len(data) >= 8 {
+		size := uint64(binary.BigEndian.Uint32(data[0:4]))
+		typ := string(data[4:8])
+		header := uint64(8)
+		switch size {
+		case 0:
+			// The box extends to the end of the data
+			size = uint64(len(data))
+		case 1:
+			// A 64-bit size follows the type
+			if len(data) < 16 {
+				return
+			}
+			size = binary.BigEndian.Uint64(data[8:16])
+			header = 16
+		}
+		if size < header || size > uint64(len(data)) {
+			return
+		}
+		if !fn(typ, data[header:size]) {
+			return
+		}
+		data = data[size:]
+	}
+}
@@ -0,0 +1,276 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"log"
+	"mime/multipart"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/image/draw"
+)
+
+// variantJPEGQuality is the JPEG quality of re-encoded variants.
+const variantJPEGQuality = 82
+
+// ImageVariantSpec names a variant width generated for every post image.
+type ImageVariantSpec struct {
+	Name  string
+	Width int
+}
+
+// ResponsiveImage is an uploaded image together with its resized variants.
+type ResponsiveImage struct {
+	Key      string
+	Variants []schematype.ImageVariant
+	// MediaID identifies the Media entity recording the original.
+	MediaID int
+}
+
+// ImageSet is the image of a post as returned by the API: the "original" URL,
+// one URL per variant name, a "srcset" attribute value listing the variants by
+// width, and "srcset_type", the MIME type of the variants. Variants are only
+// ever JPEG or PNG, so the type differs from the original's for WebP and GIF
+// uploads; underscores are not allowed in variant names, so it never clashes
+// with one.
+type ImageSet map[string]string
+
+// NewImageSet builds the ImageSet of an image URL and its variants, whose URLs must be resolved.
+// Returns nil when there is no image.
+func NewImageSet(imageURL string, variants []schematype.ImageVariant) ImageSet {
+	if imageURL == "" {
+		return nil
+	}
+
+	set := ImageSet{"original": imageURL}
+	srcset := make([]string, 0, len(variants))
+	for _, v := range variants {
+		set[v.Name] = v.URL
+		srcset = append(srcset, fmt.Sprintf("%s %dw", v.URL, v.Width))
+	}
+	if len(srcset) > 0 {
+		set["srcset"] = strings.Join(srcset, ", ")
+		if t := variantContentType(variants[0].URL); t != "" {
+			set["srcset_type"] = t
+		}
+	}
+	return set
+}
+
+// variantContentType returns the MIME type of a variant from the extension of
+// its URL, or "" when it is not one variants are encoded as.
+func variantContentType(ref string) string {
+	if u, err := url.Parse(ref); err == nil {
+		ref = u.Path
+	}
+	switch strings.ToLower(path.Ext(ref)) {
+	case formatJPEG.ext, ".jpeg":
+		return formatJPEG.contentType
+	case formatPNG.ext:
+		return formatPNG.contentType
+	}
+	return ""
+}
+
+// UploadResponsiveImage uploads an image like UploadImage and stores a resized
+// variant for every configured width narrower than the original. Variants are
+// re-encoded as JPEG, or PNG when the image has transparency, whatever the
+// original's format: there is no WebP or AVIF encoder, so WebP uploads get
+// JPEG or PNG variants. Formats that cannot be decoded (AVIF) are stored
+// without variants.
+func (s *ImageService) UploadResponsiveImage(ctx echo.Context, file *multipart.FileHeader, meta MediaInput) (*ResponsiveImage, error) {
+	data, info, err := s.readUpload(file)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.store(ctx.Request().Context(), data, info)
+	if err != nil {
+		return nil, err
+	}
+	result := &ResponsiveImage{Key: key}
+
+	if err := s.storeVariants(ctx, key, data, info, result); err != nil {
+		s.deleteKeys(ctx.Request().Context(), key, result.Variants)
+		return nil, err
+	}
+
+	m, err := s.record(ctx.Request().Context(), key, file.Filename, data, info, result.Variants, meta)
+	if err != nil {
+		s.deleteKeys(ctx.Request().Context(), key, result.Variants)
+		return nil, err
+	}
+	result.MediaID = m.ID
+	return result, nil
+}
+
+// storeVariants stores the configured variants of the image just stored under key, appending them to result.
+func (s *ImageService) storeVariants(ctx echo.Context, key string, data []byte, info *imageInfo, result *ResponsiveImage) error {
+	if len(s.variants) == 0 || info.format == formatAVIF {
+		return nil
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		// The header was valid, so keep the original and skip the variants
+		log.Printf("Error decoding image '%s' for variants: %v", key, err)
+		return nil
+	}
+
+	base := strings.TrimSuffix(key, info.format.ext)
+	for _, spec := range s.variants {
+		if spec.Width >= info.width {
+			continue
+		}
+		variant, err := s.storeVariant(ctx.Request().Context(), src, base, spec)
+		if err != nil {
+			return err
+		}
+		result.Variants = append(result.Variants, *variant)
+	}
+	return nil
+}
+
+// storeVariant resizes src to spec.Width, preserving the aspect ratio, and stores
+// it under "<base>_<name>.<ext>".
+func (s *ImageService) storeVariant(ctx context.Context, src image.Image, base string, spec ImageVariantSpec) (*schematype.ImageVariant, error) {
+	bounds := src.Bounds()
+	height := bounds.Dy() * spec.Width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	dst := scaleImage(src, bounds, spec.Width, height)
+	data, format, err := encodeImage(dst, nil, variantJPEGQuality)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s variant: %w", spec.Name, err)
+	}
+
+	key := fmt.Sprintf("%s_%s%s", base, spec.Name, format.ext)
+	if err := s.storage.Put(ctx, key, bytes.NewReader(data), int64(len(data)), format.contentType); err != nil {
+		return nil, err
+	}
+	return &schematype.ImageVariant{Name: spec.Name, Key: key, Width: spec.Width, Height: height}, nil
+}
+
+// DeleteResponsiveImage removes a stored image and its variants, along with its media library entry.
+func (s *ImageService) DeleteResponsiveImage(ctx context.Context, ref string, variants []schematype.ImageVariant) error {
+	var errs []error
+	if err := s.DeleteImage(ctx, ref); err != nil {
+		errs = append(errs, err)
+	}
+	for _, v := range variants {
+		if err := s.DeleteImage(ctx, variantRef(v)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// releasePostUpload deletes the media entry id and its files if it was created
+// by uploading a post's image and no post, trashed ones included, or author
+// uses it any more. Failures are only logged, as the post was saved already.
+func (s *ImageService) releasePostUpload(ctx context.Context, id int) {
+	m, err := s.client.Media.Query().
+		Where(media.ID(id), media.PostUpload(true)).
+		Select(media.FieldKey, media.FieldVariants).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			log.Printf("Error retrieving uploaded post image %d: %v", id, err)
+		}
+		return
+	}
+	avatar, err := s.client.Author.Query().Where(author.AvatarEQ(m.Key)).Exist(ctx)
+	if err != nil || avatar {
+		if err != nil {
+			log.Printf("Error checking uses of uploaded post image %d: %v", id, err)
+		}
+		return
+	}
+
+	// The conditions are checked again as the row is deleted, so an entry
+	// attached to another post or edited in the meantime is kept
+	n, err := s.client.Media.Delete().
+		Where(media.ID(id), media.PostUpload(true), media.Not(media.HasPosts())).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("Error deleting uploaded post image %d: %v", id, err)
+		return
+	}
+	if n == 1 {
+		s.deleteKeys(ctx, m.Key, m.Variants)
+	}
+}
+
+// deleteKeys removes a stored original, its variants and its media library entry.
+func (s *ImageService) deleteKeys(ctx context.Context, key string, variants []schematype.ImageVariant) {
+	if _, err := s.client.Media.Delete().Where(media.Key(key)).Exec(ctx); err != nil {
+		log.Printf("Error deleting image record '%s': %v", key, err)
+	}
+	s.purgeTransforms(key)
+	if err := s.storage.Delete(ctx, key); err != nil {
+		log.Printf("Error deleting image '%s': %v", key, err)
+	}
+	for _, v := range variants {
+		if err := s.DeleteImage(ctx, variantRef(v)); err != nil {
+			log.Printf("Error deleting image variant '%s': %v", variantRef(v), err)
+		}
+	}
+}
+
+// variantRef returns the stored reference of v: its key, or the URL of a variant stored before keys were.
+func variantRef(v schematype.ImageVariant) string {
+	if v.Key != "" {
+		return v.Key
+	}
+	return v.URL
+}
+
+// scaleImage resamples the rectangle r of src to width×height pixels.
+func scaleImage(src image.Image, r image.Rectangle, width, height int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, r, draw.Src, nil)
+	return dst
+}
+
+// encodeImage encodes img as format, which must be JPEG or PNG. A nil format
+// picks JPEG for opaque images and PNG for images with transparency.
+// quality applies to JPEG only.
+func encodeImage(img *image.NRGBA, format *imageFormat, quality int) ([]byte, *imageFormat, error) {
+	if format == nil {
+		format = formatPNG
+		if img.Opaque() {
+			format = formatJPEG
+		}
+	}
+
+	var (
+		buf bytes.Buffer
+		err error
+	)
+	switch format {
+	case formatJPEG:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality})
+	case formatPNG:
+		err = png.Encode(&buf, img)
+	default:
+		err = fmt.Errorf("cannot encode %s images", format.name)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), format, nil
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+)
+
+func TestNewImageSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		image    string
+		variants []schematype.ImageVariant
+		want     ImageSet
+	}{
+		{name: "no image"},
+		{
+			name:  "no variants",
+			image: "http://localhost/images/a.webp",
+			want:  ImageSet{"original": "http://localhost/images/a.webp"},
+		},
+		{
+			// WebP originals get JPEG variants, which srcset_type states
+			name:  "webp original with jpeg variants",
+			image: "http://localhost/images/a.webp",
+			variants: []schematype.ImageVariant{
+				{Name: "thumbnail", URL: "http://localhost/images/a_thumbnail.jpg", Width: 320},
+				{Name: "card", URL: "http://localhost/images/a_card.jpg", Width: 768},
+			},
+			want: ImageSet{
+				"original":    "http://localhost/images/a.webp",
+				"thumbnail":   "http://localhost/images/a_thumbnail.jpg",
+				"card":        "http://localhost/images/a_card.jpg",
+				"srcset":      "http://localhost/images/a_thumbnail.jpg 320w, http://localhost/images/a_card.jpg 768w",
+				"srcset_type": "image/jpeg",
+			},
+		},
+		{
+			name:     "png variants",
+			image:    "/images/a.png",
+			variants: []schematype.ImageVariant{{Name: "thumbnail", URL: "/images/a_thumbnail.png?v=1", Width: 320}},
+			want: ImageSet{
+				"original":    "/images/a.png",
+				"thumbnail":   "/images/a_thumbnail.png?v=1",
+				"srcset":      "/images/a_thumbnail.png?v=1 320w",
+				"srcset_type": "image/png",
+			},
+		},
+		{
+			name:     "variant of unknown type",
+			image:    "https://cdn.example.com/a",
+			variants: []schematype.ImageVariant{{Name: "thumbnail", URL: "https://cdn.example.com/a-small", Width: 320}},
+			want: ImageSet{
+				"original":  "https://cdn.example.com/a",
+				"thumbnail": "https://cdn.example.com/a-small",
+				"srcset":    "https://cdn.example.com/a-small 320w",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewImageSet(tt.image, tt.variants); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewImageSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
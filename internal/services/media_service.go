@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/schema"
+	"github.com/AdongoJr2/technoprise-backend/ent/user"
+)
+
+// MediaService provides business logic for the media library.
+type MediaService struct {
+	client       *ent.Client
+	imageService *ImageService
+}
+
+// NewMediaService creates a new MediaService.
+func NewMediaService(client *ent.Client, imageService *ImageService) *MediaService {
+	return &MediaService{
+		client:       client,
+		imageService: imageService,
+	}
+}
+
+// MediaInput describes a media library entry. On update, nil fields are left
+// untouched and empty strings clear the field.
+type MediaInput struct {
+	AltText *string `json:"alt_text,omitempty"`
+	Caption *string `json:"caption,omitempty"`
+	// UploaderID records who uploaded the file; it is only used on upload.
+	UploaderID *int `json:"-"`
+	// PostUpload marks a file uploaded as a post's image; it is only used on upload.
+	PostUpload bool `json:"-"`
+}
+
+// ListMediaParams holds the paging and search parameters of a media listing.
+type ListMediaParams struct {
+	Page  int
+	Limit int
+	// Search matches the filename, alt text or caption, case-insensitively.
+	Search string
+}
+
+// PaginatedMedia holds media library entries and pagination metadata.
+type PaginatedMedia struct {
+	Data       []*ent.Media   `json:"data"`
+	Pagination PaginationMeta `json:"pagination"`
+}
+
+// MediaView is a media library entry as returned by the API, with its public
+// URL and its variants combined into an ImageSet.
+type MediaView struct {
+	*ent.Media
+	URL   string   `json:"url"`
+	Image ImageSet `json:"image"`
+	// Variants hides the raw variants, which are part of Image.
+	Variants *struct{} `json:"variants,omitempty"`
+}
+
+// NewMediaView wraps m, stored at url, for an API response.
+func NewMediaView(m *ent.Media, url string) *MediaView {
+	return &MediaView{
+		Media: m,
+		URL:   url,
+		Image: NewImageSet(url, m.Variants),
+	}
+}
+
+// GetMedia retrieves a page of media library entries, newest first.
+func (s *MediaService) GetMedia(ctx context.Context, params ListMediaParams) (*PaginatedMedia, error) {
+	page, limit := params.Page, params.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10 // Default limit
+	}
+
+	query := s.client.Media.Query()
+	if params.Search != "" {
+		query = query.Where(media.Or(
+			media.FilenameContainsFold(params.Search),
+			media.AltTextContainsFold(params.Search),
+			media.CaptionContainsFold(params.Search),
+		))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		log.Printf("Error counting media: %v", err)
+		return nil, fmt.Errorf("failed to count media: %w", err)
+	}
+
+	items, err := query.
+		WithUploader(withUploaderSummary).
+		Order(ent.Desc(media.FieldCreateTime), ent.Desc(media.FieldID)).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		log.Printf("Error fetching paginated media: %v", err)
+		return nil, fmt.Errorf("failed to fetch media: %w", err)
+	}
+	for _, m := range items {
+		s.imageService.resolveVariants(ctx, m.Variants)
+	}
+
+	return &PaginatedMedia{
+		Data: items,
+		Pagination: PaginationMeta{
+			Total:      total,
+			Page:       page,
+			Limit:      limit,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		},
+	}, nil
+}
+
+// GetMediaByID retrieves a single media library entry with its uploader and the posts using it.
+func (s *MediaService) GetMediaByID(ctx context.Context, id int) (*ent.Media, error) {
+	m, err := s.client.Media.Query().
+		Where(media.ID(id)).
+		WithUploader(withUploaderSummary).
+		WithPosts(func(q *ent.BlogPostQuery) {
+			q.Select(blogpost.FieldTitle, blogpost.FieldSlug, blogpost.FieldStatus)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("media %d %w", id, ErrNotFound)
+		}
+		log.Printf("Error fetching media %d: %v", id, err)
+		return nil, fmt.Errorf("failed to retrieve media: %w", err)
+	}
+	s.imageService.resolveVariants(ctx, m.Variants)
+	return m, nil
+}
+
+// UpdateMedia changes the alt text and caption of a media library entry. An
+// entry edited in the library is kept when the post it was uploaded for stops
+// using it.
+func (s *MediaService) UpdateMedia(ctx context.Context, id int, input MediaInput) (*ent.Media, error) {
+	mediaUpdate := s.client.Media.UpdateOneID(id).SetPostUpload(false)
+
+	if input.AltText != nil {
+		if *input.AltText == "" {
+			mediaUpdate = mediaUpdate.ClearAltText()
+		} else {
+			mediaUpdate = mediaUpdate.SetAltText(*input.AltText)
+		}
+	}
+	if input.Caption != nil {
+		if *input.Caption == "" {
+			mediaUpdate = mediaUpdate.ClearCaption()
+		} else {
+			mediaUpdate = mediaUpdate.SetCaption(*input.Caption)
+		}
+	}
+
+	if _, err := mediaUpdate.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("media %d %w", id, ErrNotFound)
+		}
+		if ent.IsValidationError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		return nil, fmt.Errorf("failed to update media: %w", err)
+	}
+	return s.GetMediaByID(ctx, id)
+}
+
+// DeleteMedia deletes a media library entry and its files. With detach set,
+// posts using it are left without an image and authors without an avatar;
+// without it, an entry still in use is kept and an ErrConflict returned.
+func (s *MediaService) DeleteMedia(ctx context.Context, id int, detach bool) error {
+	m, err := s.client.Media.Query().
+		Where(media.ID(id)).
+		Select(media.FieldKey, media.FieldVariants).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("media %d %w", id, ErrNotFound)
+		}
+		return fmt.Errorf("failed to retrieve media: %w", err)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback() // no-op once committed
+
+	if !detach {
+		// Posts in the trash may still be restored, so they count too
+		inUse, err := tx.BlogPost.Query().
+			Where(blogpost.HasMediaWith(media.ID(id))).
+			Exist(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to check media usage: %w", err)
+		}
+		if !inUse {
+			inUse, err = tx.Author.Query().Where(author.AvatarEQ(m.Key)).Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to check media usage: %w", err)
+			}
+		}
+		if inUse {
+			return fmt.Errorf("%w: media %d is still used by blog posts or authors", ErrConflict, id)
+		}
+	}
+
+	err = tx.BlogPost.Update().
+		Where(blogpost.HasMediaWith(media.ID(id))).
+		ClearImage().
+		ClearImageVariants().
+		ClearMedia().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detach media from blog posts: %w", err)
+	}
+
+	err = tx.Author.Update().
+		Where(author.AvatarEQ(m.Key)).
+		ClearAvatar().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detach media from authors: %w", err)
+	}
+
+	if err := tx.Media.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete media: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit media deletion: %w", err)
+	}
+
+	// The entry is gone, so a file left behind is only logged
+	s.imageService.deleteKeys(ctx, m.Key, m.Variants)
+	return nil
+}
+
+// AuthorizeMediaAccess checks whether actor may change or delete the media library entry id.
+// Admins and editors may manage any entry; authors only their own uploads.
+func (s *MediaService) AuthorizeMediaAccess(ctx context.Context, id int, actor *ent.User) error {
+	if actor == nil {
+		return ErrForbidden
+	}
+
+	switch actor.Role {
+	case user.RoleAdmin, user.RoleEditor:
+		return nil
+	case user.RoleAuthor:
+		exists, err := s.client.Media.Query().Where(media.ID(id)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve media: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("media %d %w", id, ErrNotFound)
+		}
+		owned, err := s.client.Media.Query().
+			Where(media.ID(id), media.HasUploaderWith(user.ID(actor.ID))).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve media uploader: %w", err)
+		}
+		if owned {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: you may only manage your own uploads", ErrForbidden)
+}
+
+// withUploaderSummary loads only the name of a media entry's uploader.
+func withUploaderSummary(q *ent.UserQuery) {
+	q.Select(user.FieldName)
+}
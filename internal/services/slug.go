@@ -0,0 +1,49 @@
+package services
+
+import (
+	"fmt"
+	"log"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent"
+)
+
+// maxSlugAttempts bounds how often a write that lost its slug to a
+// concurrent one is retried.
+const maxSlugAttempts = 5
+
+// slugFamily matches records whose slug is base or base with a suffix, the
+// slugs nextFreeSlug has to step around.
+func slugFamily[P ~func(*sql.Selector)](base string) P {
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(sql.EQ(s.C("slug"), base), sql.HasPrefix(s.C("slug"), base+"-")))
+	}
+}
+
+// nextFreeSlug returns base, or base with the lowest suffix from -2 up that
+// is not taken.
+func nextFreeSlug(base string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, slug := range taken {
+		used[slug] = true
+	}
+	slug := base
+	for n := 2; used[slug]; n++ {
+		slug = fmt.Sprintf("%s-%d", base, n)
+	}
+	return slug
+}
+
+// retrySlugConflict runs write again when it fails on a constraint, which is
+// how a concurrent write that took the same slug between the uniqueness check
+// and the insert surfaces. The next attempt sees that slug taken and picks
+// the following one.
+func retrySlugConflict[T any](write func() (T, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		v, err := write()
+		if err == nil || !ent.IsConstraintError(err) || attempt == maxSlugAttempts {
+			return v, err
+		}
+		log.Printf("Retrying write after constraint violation: %v", err)
+	}
+}
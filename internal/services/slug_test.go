@@ -0,0 +1,93 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+)
+
+func TestNextFreeSlug(t *testing.T) {
+	tests := []struct {
+		name  string
+		base  string
+		taken []string
+		want  string
+	}{
+		{name: "free", base: "go-tips", want: "go-tips"},
+		{name: "base taken", base: "go-tips", taken: []string{"go-tips"}, want: "go-tips-2"},
+		{name: "suffixes taken", base: "go-tips", taken: []string{"go-tips", "go-tips-2", "go-tips-3"}, want: "go-tips-4"},
+		{name: "gap is filled", base: "go-tips", taken: []string{"go-tips", "go-tips-3"}, want: "go-tips-2"},
+		{name: "only suffixes taken", base: "go-tips", taken: []string{"go-tips-2"}, want: "go-tips"},
+		{name: "longer slugs in the family are ignored", base: "go", taken: []string{"go", "go-tips", "go-2-go"}, want: "go-2"},
+		{name: "numeric base", base: "2024", taken: []string{"2024", "2024-2"}, want: "2024-3"},
+		{name: "base ending in a number", base: "top-10", taken: []string{"top-10"}, want: "top-10-2"},
+		{name: "unordered", base: "a", taken: []string{"a-4", "a-2", "a", "a-3"}, want: "a-5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextFreeSlug(tt.base, tt.taken); got != tt.want {
+				t.Errorf("nextFreeSlug(%q, %q) = %q, want %q", tt.base, tt.taken, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlugFamily(t *testing.T) {
+	s := sql.Dialect(dialect.Postgres).Select("slug").From(sql.Table("categories"))
+	slugFamily[predicate.Category]("go_tips%")(s)
+
+	query, args := s.Query()
+	want := `SELECT "slug" FROM "categories" WHERE "categories"."slug" = $1 OR "categories"."slug" LIKE $2`
+	if query != want {
+		t.Errorf("query = %s, want %s", query, want)
+	}
+	// LIKE wildcards in the base match themselves only
+	wantArgs := []any{"go_tips%", `go\_tips\%-%`}
+	if len(args) != len(wantArgs) || args[0] != wantArgs[0] || args[1] != wantArgs[1] {
+		t.Errorf("args = %q, want %q", args, wantArgs)
+	}
+}
+
+func TestRetrySlugConflict(t *testing.T) {
+	errOther := errors.New("connection reset")
+
+	tests := []struct {
+		name      string
+		failures  int
+		err       error
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "success", wantCalls: 1},
+		{name: "retried after a conflict", failures: 2, err: &ent.ConstraintError{}, wantCalls: 3},
+		{name: "gives up after max attempts", failures: maxSlugAttempts + 1, err: &ent.ConstraintError{}, wantCalls: maxSlugAttempts, wantErr: true},
+		{name: "other errors are not retried", failures: 1, err: errOther, wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got, err := retrySlugConflict(func() (int, error) {
+				calls++
+				if calls <= tt.failures {
+					return 0, tt.err
+				}
+				return calls, nil
+			})
+			if calls != tt.wantCalls {
+				t.Errorf("write called %d times, want %d", calls, tt.wantCalls)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("retrySlugConflict() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != calls {
+				t.Errorf("retrySlugConflict() = %d, want %d", got, calls)
+			}
+		})
+	}
+}
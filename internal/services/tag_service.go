@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/predicate"
+	"github.com/AdongoJr2/technoprise-backend/ent/tag"
+	"github.com/AdongoJr2/technoprise-backend/internal/utils"
+)
+
+// TagService provides business logic for post tags.
+type TagService struct {
+	client *ent.Client
+}
+
+// NewTagService creates a new TagService.
+func NewTagService(client *ent.Client) *TagService {
+	return &TagService{
+		client: client,
+	}
+}
+
+// TagInput defines the input structure for creating or updating a tag.
+type TagInput struct {
+	Name *string `json:"name,omitempty"`
+	Slug *string `json:"slug,omitempty"`
+}
+
+// TagWithCount is a tag with the number of published posts carrying it.
+type TagWithCount struct {
+	*ent.Tag
+	PostCount int `json:"post_count"`
+}
+
+// CreateTag creates a new tag.
+func (s *TagService) CreateTag(ctx context.Context, input TagInput) (*ent.Tag, error) {
+	return retrySlugConflict(func() (*ent.Tag, error) {
+		return s.createTag(ctx, input)
+	})
+}
+
+// createTag makes a single attempt at CreateTag.
+func (s *TagService) createTag(ctx context.Context, input TagInput) (*ent.Tag, error) {
+	if input.Name == nil || *input.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidInput)
+	}
+
+	slugSource := *input.Name
+	if input.Slug != nil && *input.Slug != "" {
+		slugSource = *input.Slug
+	}
+	slug, err := s.uniqueSlug(ctx, utils.GenerateSlug(slugSource), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.client.Tag.Create().SetName(*input.Name).SetSlug(slug).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+	return t, nil
+}
+
+// UpdateTag applies a partial update to the tag identified by slug.
+func (s *TagService) UpdateTag(ctx context.Context, slug string, input TagInput) (*ent.Tag, error) {
+	return retrySlugConflict(func() (*ent.Tag, error) {
+		return s.updateTag(ctx, slug, input)
+	})
+}
+
+// updateTag makes a single attempt at UpdateTag.
+func (s *TagService) updateTag(ctx context.Context, slug string, input TagInput) (*ent.Tag, error) {
+	t, err := s.GetTagBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	tagUpdate := t.Update()
+
+	if input.Name != nil {
+		if *input.Name == "" {
+			return nil, fmt.Errorf("%w: name cannot be empty", ErrInvalidInput)
+		}
+		tagUpdate = tagUpdate.SetName(*input.Name)
+	}
+
+	if input.Slug != nil && *input.Slug != "" {
+		newSlug := utils.GenerateSlug(*input.Slug)
+		if newSlug != t.Slug {
+			newSlug, err = s.uniqueSlug(ctx, newSlug, t.ID)
+			if err != nil {
+				return nil, err
+			}
+			tagUpdate = tagUpdate.SetSlug(newSlug)
+		}
+	}
+
+	updated, err := tagUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteTag deletes the tag identified by slug and detaches it from its posts.
+func (s *TagService) DeleteTag(ctx context.Context, slug string) error {
+	t, err := s.GetTagBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Tag.DeleteOne(t).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	return nil
+}
+
+// GetTags lists all tags ordered by name, with published post counts for tag clouds.
+func (s *TagService) GetTags(ctx context.Context) ([]*TagWithCount, error) {
+	tags, err := s.client.Tag.Query().
+		Order(ent.Asc(tag.FieldName), ent.Asc(tag.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Printf("Error fetching tags: %v", err)
+		return nil, fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	var counts []struct {
+		TagID int `sql:"tag_id"`
+		Count int `sql:"count"`
+	}
+	err = s.client.BlogPost.Query().
+		Where(isPubliclyVisible()).
+		Modify(func(s *sql.Selector) {
+			t := sql.Table(tag.PostsTable)
+			s.Join(t).On(s.C(blogpost.FieldID), t.C(tag.PostsPrimaryKey[1]))
+			s.Select(
+				sql.As(t.C(tag.PostsPrimaryKey[0]), "tag_id"),
+				sql.As(sql.Count("*"), "count"),
+			).GroupBy(t.C(tag.PostsPrimaryKey[0]))
+		}).
+		Scan(ctx, &counts)
+	if err != nil {
+		log.Printf("Error counting tag posts: %v", err)
+		return nil, fmt.Errorf("failed to count tag posts: %w", err)
+	}
+
+	countByID := make(map[int]int, len(counts))
+	for _, c := range counts {
+		countByID[c.TagID] = c.Count
+	}
+
+	result := make([]*TagWithCount, len(tags))
+	for i, t := range tags {
+		result[i] = &TagWithCount{Tag: t, PostCount: countByID[t.ID]}
+	}
+	return result, nil
+}
+
+// GetTagBySlug retrieves a single tag.
+func (s *TagService) GetTagBySlug(ctx context.Context, slug string) (*ent.Tag, error) {
+	t, err := s.client.Tag.Query().Where(tag.SlugEQ(slug)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("tag with slug '%s' %w", slug, ErrNotFound)
+		}
+		log.Printf("Error fetching tag by slug '%s': %v", slug, err)
+		return nil, fmt.Errorf("failed to retrieve tag: %w", err)
+	}
+	return t, nil
+}
+
+// uniqueSlug returns slug, or slug with the lowest free suffix from -2 up if
+// another tag (other than excludeID) already uses it.
+func (s *TagService) uniqueSlug(ctx context.Context, slug string, excludeID int) (string, error) {
+	if slug == "" {
+		return "", fmt.Errorf("%w: slug cannot be empty", ErrInvalidInput)
+	}
+	taken, err := s.client.Tag.Query().
+		Where(slugFamily[predicate.Tag](slug), tag.IDNEQ(excludeID)).
+		Select(tag.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for existing tag slug: %w", err)
+	}
+	return nextFreeSlug(slug, taken), nil
+}
+
+// withTagSummary loads only the tag fields shown on a post.
+func withTagSummary(q *ent.TagQuery) {
+	q.Select(tag.FieldName, tag.FieldSlug)
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent/author"
+	"github.com/AdongoJr2/technoprise-backend/ent/blogpost"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/schema"
+	"github.com/AdongoJr2/technoprise-backend/internal/storage"
+)
+
+// OrphanOptions configures a collection of orphaned uploads.
+type OrphanOptions struct {
+	// GracePeriod spares files younger than this, which may belong to an upload
+	// whose record is still being saved.
+	GracePeriod time.Duration
+	// DryRun reports orphans without deleting them.
+	DryRun bool
+}
+
+// Orphan is a stored file that no media entry, post or author refers to.
+type Orphan struct {
+	Key     string    `json:"key"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// OrphanReport is the outcome of CollectOrphans.
+type OrphanReport struct {
+	// Scanned counts the stored files.
+	Scanned int `json:"scanned"`
+	// Orphans lists the unreferenced files older than the grace period.
+	Orphans []Orphan `json:"orphans"`
+	// Recent counts the unreferenced files still within the grace period.
+	Recent int `json:"recent"`
+	// Deleted counts the orphans removed; always 0 in a dry run.
+	Deleted int `json:"deleted"`
+	// Bytes is the total size of Orphans.
+	Bytes int64 `json:"bytes"`
+}
+
+// CollectOrphans compares the stored files against the keys and URLs recorded
+// in the database and deletes the files nothing refers to, such as uploads
+// whose post failed to save. Files younger than the grace period are spared.
+// Deletion failures are logged, and returned joined once every orphan was tried.
+func (s *ImageService) CollectOrphans(ctx context.Context, opts OrphanOptions) (*OrphanReport, error) {
+	// References are loaded first, so files stored after this point are young
+	// enough to be spared by any sensible grace period
+	referenced, err := s.referencedKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &OrphanReport{Orphans: []Orphan{}}
+	cutoff := time.Now().Add(-opts.GracePeriod)
+	err = s.storage.List(ctx, func(key string, info storage.ObjectInfo) error {
+		report.Scanned++
+		if referenced[key] {
+			return nil
+		}
+		if info.ModTime.After(cutoff) {
+			report.Recent++
+			return nil
+		}
+		report.Orphans = append(report.Orphans, Orphan{Key: key, Size: info.Size, ModTime: info.ModTime})
+		report.Bytes += info.Size
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	var errs []error
+	for _, o := range report.Orphans {
+		used, err := s.releaseOrphanMedia(ctx, o.Key)
+		if err != nil {
+			log.Printf("Error deleting media of orphaned upload '%s': %v", o.Key, err)
+			errs = append(errs, err)
+			continue
+		}
+		if used {
+			// Attached to a post since the references were loaded
+			continue
+		}
+		if err := s.storage.Delete(ctx, o.Key); err != nil {
+			log.Printf("Error deleting orphaned upload '%s': %v", o.Key, err)
+			errs = append(errs, err)
+			continue
+		}
+		report.Deleted++
+	}
+	return report, errors.Join(errs...)
+}
+
+// releaseOrphanMedia deletes the unused entries created by uploading a post's
+// image that record the orphaned file key, and reports whether the file has
+// entries still in use, such as one attached to a post meanwhile.
+func (s *ImageService) releaseOrphanMedia(ctx context.Context, key string) (bool, error) {
+	_, err := s.client.Media.Delete().
+		Where(media.Key(key), media.PostUpload(true), media.Not(media.HasPosts())).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete orphaned media: %w", err)
+	}
+	used, err := s.client.Media.Query().Where(media.Key(key)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch media: %w", err)
+	}
+	return used, nil
+}
+
+// StartOrphanCollector runs CollectOrphans every interval until ctx is done, logging the outcome.
+func (s *ImageService) StartOrphanCollector(ctx context.Context, interval time.Duration, opts OrphanOptions) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				report, err := s.CollectOrphans(ctx, opts)
+				if err != nil {
+					log.Printf("Error collecting orphaned uploads: %v", err)
+				}
+				if report != nil && len(report.Orphans) > 0 {
+					log.Printf("Deleted %d of %d orphaned uploads (%d bytes)", report.Deleted, len(report.Orphans), report.Bytes)
+				}
+			}
+		}
+	}()
+}
+
+// referencedKeys returns the storage keys of every file recorded in the media
+// library or referenced by a post image, image variant or author avatar.
+func (s *ImageService) referencedKeys(ctx context.Context) (map[string]bool, error) {
+	keys := make(map[string]bool)
+	addRef := func(ref string) {
+		if ref == "" {
+			return
+		}
+		if key, ok := s.keyFromRef(ref); ok {
+			keys[key] = true
+		}
+		// Also keep the file named by a URL's last segment, in case it was
+		// recorded under a different base URL than the current one
+		if !isStoredURL(ref) {
+			return
+		}
+		if parsed, err := url.Parse(ref); err == nil {
+			keys[path.Base(parsed.Path)] = true
+		}
+	}
+
+	avatars, err := s.client.Author.Query().
+		Where(author.AvatarNEQ("")).
+		Select(author.FieldAvatar).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch author avatars: %w", err)
+	}
+	for _, a := range avatars {
+		addRef(a)
+	}
+
+	// Entries created by uploading a post's image only count while something
+	// uses them; they are left behind when that post's update or purge could
+	// not remove them
+	entries, err := s.client.Media.Query().
+		Where(media.Or(
+			media.PostUpload(false),
+			media.HasPosts(),
+			media.KeyIn(avatars...),
+		)).
+		Select(media.FieldKey, media.FieldVariants).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch media: %w", err)
+	}
+	for _, m := range entries {
+		keys[m.Key] = true
+		for _, v := range m.Variants {
+			addRef(variantRef(v))
+		}
+	}
+
+	// Images of trashed posts are kept until the posts are purged
+	posts, err := s.client.BlogPost.Query().
+		Where(blogpost.ImageNEQ("")).
+		Select(blogpost.FieldImage, blogpost.FieldImageVariants).
+		All(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blog post images: %w", err)
+	}
+	for _, p := range posts {
+		addRef(p.Image)
+		for _, v := range p.ImageVariants {
+			addRef(variantRef(v))
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AdongoJr2/technoprise-backend/ent"
+	"github.com/AdongoJr2/technoprise-backend/ent/enttest"
+	"github.com/AdongoJr2/technoprise-backend/ent/media"
+	"github.com/AdongoJr2/technoprise-backend/ent/schematype"
+	"github.com/AdongoJr2/technoprise-backend/internal/storage"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestClient returns an ent client on a private in-memory SQLite database
+// with the schema migrated.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", name))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// gcFixture is a local storage directory and a database referring to some of its files.
+type gcFixture struct {
+	service *ImageService
+	dir     string
+}
+
+// referencedFiles are the files the gcFixture database refers to, one way each.
+var referencedFiles = []string{
+	"media.jpg",          // media entry
+	"media-320.jpg",      // media variant
+	"upload.jpg",         // media entry created by uploading a post's image, in use
+	"post.jpg",           // post image, no media entry
+	"post-320.jpg",       // post image variant
+	"trashed.jpg",        // image of a post in the trash
+	"trashed-320.jpg",    // variant of a post in the trash
+	"avatar.png",         // author avatar
+	"legacy-avatar.webp", // author avatar stored as a URL before keys were
+}
+
+// orphanFiles are the old files nothing uses: one unrecorded, and one recorded
+// by an entry created for a post image that the post no longer uses.
+var orphanFiles = []string{"orphan.jpg", "unused-upload.jpg"}
+
+// newGCFixture stores the referenced files, the old orphans and a recent one,
+// and records the references.
+func newGCFixture(t *testing.T) *gcFixture {
+	t.Helper()
+	ctx := context.Background()
+	client := newTestClient(t)
+	dir := t.TempDir()
+	store, err := storage.NewLocal(dir, "http://localhost/images")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	old := time.Now().Add(-48 * time.Hour)
+	for _, key := range allFiles() {
+		path := filepath.Join(dir, key)
+		if err := os.WriteFile(path, []byte("image"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if key != "recent-orphan.jpg" {
+			if err := os.Chtimes(path, old, old); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	client.Media.Create().
+		SetKey("media.jpg").SetFormat("jpeg").SetMimeType("image/jpeg").
+		SetWidth(640).SetHeight(480).SetSize(5).
+		SetVariants([]schematype.ImageVariant{{Name: "thumbnail", Key: "media-320.jpg", Width: 320, Height: 240}}).
+		SaveX(ctx)
+	upload := client.Media.Create().
+		SetKey("upload.jpg").SetFormat("jpeg").SetMimeType("image/jpeg").
+		SetWidth(640).SetHeight(480).SetSize(5).SetPostUpload(true).
+		SaveX(ctx)
+	client.Media.Create().
+		SetKey("unused-upload.jpg").SetFormat("jpeg").SetMimeType("image/jpeg").
+		SetWidth(640).SetHeight(480).SetSize(5).SetPostUpload(true).
+		SaveX(ctx)
+	client.BlogPost.Create().
+		SetTitle("Uploaded").SetSlug("uploaded").SetContent("x").SetExcerpt("x").
+		SetImage("upload.jpg").SetMedia(upload).
+		SaveX(ctx)
+	client.BlogPost.Create().
+		SetTitle("Live").SetSlug("live").SetContent("x").SetExcerpt("x").
+		SetImage("post.jpg").
+		SetImageVariants([]schematype.ImageVariant{{Name: "thumbnail", Key: "post-320.jpg", Width: 320, Height: 240}}).
+		SaveX(ctx)
+	client.BlogPost.Create().
+		SetTitle("Trashed").SetSlug("trashed").SetContent("x").SetExcerpt("x").
+		SetImage("trashed.jpg").
+		SetImageVariants([]schematype.ImageVariant{{Name: "thumbnail", URL: "http://localhost/images/trashed-320.jpg", Width: 320, Height: 240}}).
+		SetDeletedAt(time.Now()).
+		SaveX(ctx)
+	client.Author.Create().SetName("Ann").SetSlug("ann").SetAvatar("avatar.png").SaveX(ctx)
+	client.Author.Create().SetName("Bob").SetSlug("bob").SetAvatar("https://old-cdn.example.com/uploads/legacy-avatar.webp").SaveX(ctx)
+
+	return &gcFixture{
+		service: NewImageService(client, store, ImageOptions{PublicPath: "/images"}),
+		dir:     dir,
+	}
+}
+
+// allFiles returns every file the gcFixture stores.
+func allFiles() []string {
+	files := append([]string{}, referencedFiles...)
+	return append(append(files, orphanFiles...), "recent-orphan.jpg")
+}
+
+// exists reports whether key is still stored.
+func (f *gcFixture) exists(t *testing.T, key string) bool {
+	t.Helper()
+	_, err := os.Stat(filepath.Join(f.dir, key))
+	return err == nil
+}
+
+func TestCollectOrphans(t *testing.T) {
+	f := newGCFixture(t)
+
+	report, err := f.service.CollectOrphans(context.Background(), OrphanOptions{GracePeriod: 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("CollectOrphans: %v", err)
+	}
+
+	if report.Scanned != len(allFiles()) {
+		t.Errorf("Scanned = %d, want %d", report.Scanned, len(allFiles()))
+	}
+	if keys := orphanKeys(report); keys != strings.Join(orphanFiles, ",") {
+		t.Errorf("Orphans = %s, want %v", keys, orphanFiles)
+	}
+	if report.Recent != 1 || report.Deleted != 2 || report.Bytes != 10 {
+		t.Errorf("Recent, Deleted, Bytes = %d, %d, %d, want 1, 2, 10", report.Recent, report.Deleted, report.Bytes)
+	}
+
+	for _, key := range orphanFiles {
+		if f.exists(t, key) {
+			t.Errorf("%s was not deleted", key)
+		}
+	}
+	if f.service.client.Media.Query().Where(media.Key("unused-upload.jpg")).ExistX(context.Background()) {
+		t.Error("the unused upload's media entry was not deleted")
+	}
+	if !f.exists(t, "recent-orphan.jpg") {
+		t.Error("recent-orphan.jpg was deleted within the grace period")
+	}
+	for _, key := range referencedFiles {
+		if !f.exists(t, key) {
+			t.Errorf("referenced file %s was deleted", key)
+		}
+	}
+}
+
+func TestCollectOrphansDryRun(t *testing.T) {
+	f := newGCFixture(t)
+
+	report, err := f.service.CollectOrphans(context.Background(), OrphanOptions{GracePeriod: time.Nanosecond, DryRun: true})
+	if err != nil {
+		t.Fatalf("CollectOrphans: %v", err)
+	}
+
+	if keys := orphanKeys(report); keys != "orphan.jpg,recent-orphan.jpg,unused-upload.jpg" {
+		t.Errorf("Orphans = %s, want orphan.jpg, recent-orphan.jpg and unused-upload.jpg", keys)
+	}
+	if report.Deleted != 0 {
+		t.Errorf("Deleted = %d in a dry run", report.Deleted)
+	}
+	for _, key := range allFiles() {
+		if !f.exists(t, key) {
+			t.Errorf("%s was deleted in a dry run", key)
+		}
+	}
+	if n := f.service.client.Media.Query().CountX(context.Background()); n != 3 {
+		t.Errorf("%d media entries left after a dry run, want 3", n)
+	}
+}
+
+// orphanKeys returns the sorted keys of a report's orphans, joined by commas.
+func orphanKeys(report *OrphanReport) string {
+	var keys []string
+	for _, o := range report.Orphans {
+		keys = append(keys, o.Key)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
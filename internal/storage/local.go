@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Local stores objects as files below a directory on the local filesystem.
+type Local struct {
+	root    string
+	baseURL string
+}
+
+// NewLocal creates a Local storage rooted at dir, creating the directory if needed.
+// Objects are served from baseURL, e.g. "https://api.example.com/images".
+func NewLocal(dir, baseURL string) (*Local, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("failed to create upload directory: %w", err)
+	}
+	return &Local{root: dir, baseURL: baseURL}, nil
+}
+
+// path returns the filesystem path of key.
+func (s *Local) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", fmt.Errorf("%w: '%s'", ErrInvalidKey, key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// Put writes r to a temporary file and renames it into place, so readers never see partial files.
+func (s *Local) Put(_ context.Context, key string, r io.Reader, _ int64, _ string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), p); err != nil {
+		return fmt.Errorf("failed to save file: %w", err)
+	}
+	return nil
+}
+
+// Get opens the file stored under key. The content type is derived from the
+// extension, and only images are served: any other file that ended up in the
+// directory, such as an HTML page, is reported as not existing rather than
+// served from the API's origin.
+func (s *Local) Get(_ context.Context, key string) (io.ReadSeekCloser, ObjectInfo, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+	contentType, ok := imageContentType(key)
+	if !ok {
+		return nil, ObjectInfo{}, ErrNotExist
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ObjectInfo{}, ErrNotExist
+		}
+		return nil, ObjectInfo{}, fmt.Errorf("failed to open file: %w", err)
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, ObjectInfo{}, fmt.Errorf("failed to stat file: %w", err)
+	}
+	if stat.IsDir() {
+		f.Close()
+		return nil, ObjectInfo{}, ErrNotExist
+	}
+
+	return f, ObjectInfo{
+		Size:        stat.Size(),
+		ContentType: contentType,
+		ModTime:     stat.ModTime(),
+	}, nil
+}
+
+// Delete removes the file stored under key.
+func (s *Local) Delete(_ context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+// Exists reports whether a file is stored under key.
+func (s *Local) Exists(_ context.Context, key string) (bool, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+	stat, err := os.Stat(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat file: %w", err)
+	}
+	return !stat.IsDir(), nil
+}
+
+// List walks the storage directory, including temporary files left by interrupted writes.
+func (s *Local) List(ctx context.Context, fn func(key string, info ObjectInfo) error) error {
+	return filepath.WalkDir(s.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == s.root && os.IsNotExist(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+		if d.IsDir() {
+			return ctx.Err()
+		}
+
+		stat, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil // removed while walking
+			}
+			return fmt.Errorf("failed to stat file: %w", err)
+		}
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		contentType, ok := imageContentType(key)
+		if !ok {
+			contentType = "application/octet-stream"
+		}
+		return fn(key, ObjectInfo{
+			Size:        stat.Size(),
+			ContentType: contentType,
+			ModTime:     stat.ModTime(),
+		})
+	})
+}
+
+// URL returns baseURL joined with key.
+func (s *Local) URL(key string) string {
+	return joinURL(s.baseURL, key)
+}
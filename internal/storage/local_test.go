@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalGetServesOnlyImages(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocal(dir, "http://localhost/images")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key             string
+		wantContentType string
+		wantNotExist    bool
+	}{
+		{key: "1.jpg", wantContentType: "image/jpeg"},
+		{key: "2.JPEG", wantContentType: "image/jpeg"},
+		{key: "3.png", wantContentType: "image/png"},
+		{key: "4.webp", wantContentType: "image/webp"},
+		{key: "5.gif", wantContentType: "image/gif"},
+		{key: "6.avif", wantContentType: "image/avif"},
+		{key: "page.html", wantNotExist: true},
+		{key: "page.htm", wantNotExist: true},
+		{key: "drawing.svg", wantNotExist: true},
+		{key: "script.js", wantNotExist: true},
+		{key: "notes.txt", wantNotExist: true},
+		{key: "noextension", wantNotExist: true},
+		{key: ".upload-123", wantNotExist: true},
+		{key: "page.html.jpg", wantContentType: "image/jpeg"},
+	}
+
+	for _, tt := range tests {
+		if err := os.WriteFile(filepath.Join(dir, tt.key), []byte("content"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			f, info, err := s.Get(context.Background(), tt.key)
+			if tt.wantNotExist {
+				if !errors.Is(err, ErrNotExist) {
+					t.Errorf("Get(%q) error = %v, want ErrNotExist", tt.key, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get(%q) error = %v", tt.key, err)
+			}
+			defer f.Close()
+			if info.ContentType != tt.wantContentType {
+				t.Errorf("Get(%q) content type = %q, want %q", tt.key, info.ContentType, tt.wantContentType)
+			}
+		})
+	}
+}
+
+func TestLocalListReportsOtherFilesAsBinary(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocal(dir, "http://localhost/images")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"1.png", "page.html"} {
+		if err := os.WriteFile(filepath.Join(dir, key), []byte("content"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := map[string]string{}
+	err = s.List(context.Background(), func(key string, info ObjectInfo) error {
+		got[key] = info.ContentType
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if got["1.png"] != "image/png" || got["page.html"] != "application/octet-stream" {
+		t.Errorf("List() content types = %v", got)
+	}
+}
@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Options configures an S3-compatible storage backend.
+type S3Options struct {
+	// Endpoint is the host[:port] of the S3 API, e.g. "s3.amazonaws.com" or "localhost:9000".
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	UseSSL          bool
+	// BaseURL is the public URL objects are served from. Set it to a bucket or CDN URL
+	// to serve objects directly, or to the API's own image URL to proxy them.
+	BaseURL string
+}
+
+// S3 stores objects in a bucket of an S3-compatible service such as AWS S3 or MinIO.
+type S3 struct {
+	client  *minio.Client
+	bucket  string
+	baseURL string
+}
+
+// NewS3 connects to an S3-compatible service and creates the bucket if it does not exist.
+func NewS3(ctx context.Context, opts S3Options) (*S3, error) {
+	client, err := minio.New(opts.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(opts.AccessKeyID, opts.SecretAccessKey, ""),
+		Secure: opts.UseSSL,
+		Region: opts.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, opts.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket '%s': %w", opts.Bucket, err)
+	}
+	if !exists {
+		err := client.MakeBucket(ctx, opts.Bucket, minio.MakeBucketOptions{Region: opts.Region})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create bucket '%s': %w", opts.Bucket, err)
+		}
+	}
+
+	return &S3{client: client, bucket: opts.Bucket, baseURL: opts.BaseURL}, nil
+}
+
+// Put uploads r to the bucket under key.
+func (s *S3) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if !validKey(key) {
+		return fmt.Errorf("%w: '%s'", ErrInvalidKey, key)
+	}
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+	return nil
+}
+
+// Get opens the object stored under key.
+func (s *S3) Get(ctx context.Context, key string) (io.ReadSeekCloser, ObjectInfo, error) {
+	if !validKey(key) {
+		return nil, ObjectInfo{}, fmt.Errorf("%w: '%s'", ErrInvalidKey, key)
+	}
+
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, ObjectInfo{}, fmt.Errorf("failed to get object: %w", err)
+	}
+	// GetObject is lazy; Stat issues the request and reports missing keys
+	stat, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		if isNoSuchKey(err) {
+			return nil, ObjectInfo{}, ErrNotExist
+		}
+		return nil, ObjectInfo{}, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	return obj, ObjectInfo{
+		Size:        stat.Size,
+		ContentType: stat.ContentType,
+		ModTime:     stat.LastModified,
+	}, nil
+}
+
+// Delete removes the object stored under key.
+func (s *S3) Delete(ctx context.Context, key string) error {
+	if !validKey(key) {
+		return fmt.Errorf("%w: '%s'", ErrInvalidKey, key)
+	}
+	if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+// Exists reports whether an object is stored under key.
+func (s *S3) Exists(ctx context.Context, key string) (bool, error) {
+	if !validKey(key) {
+		return false, fmt.Errorf("%w: '%s'", ErrInvalidKey, key)
+	}
+	_, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		if isNoSuchKey(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat object: %w", err)
+	}
+	return true, nil
+}
+
+// List lists every object in the bucket.
+func (s *S3) List(ctx context.Context, fn func(key string, info ObjectInfo) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // stops the listing goroutine when fn fails
+
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			return fmt.Errorf("failed to list objects: %w", obj.Err)
+		}
+		err := fn(obj.Key, ObjectInfo{
+			Size:        obj.Size,
+			ContentType: obj.ContentType,
+			ModTime:     obj.LastModified,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// URL returns baseURL joined with key.
+func (s *S3) URL(key string) string {
+	return joinURL(s.baseURL, key)
+}
+
+// isNoSuchKey reports whether err is the S3 error for a missing object.
+func isNoSuchKey(err error) bool {
+	return minio.ToErrorResponse(err).Code == minio.NoSuchKey
+}
@@ -0,0 +1,383 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 is an in-memory stand-in for the parts of the S3 API the S3 backend
+// uses, serving one bucket with path-style requests. List pages hold at most
+// pageSize keys, so pagination is exercised with few objects.
+type fakeS3 struct {
+	bucket   string
+	pageSize int
+
+	mu        sync.Mutex
+	created   bool
+	objects   map[string]fakeObject
+	listPages int
+}
+
+// fakeObject is an object stored by fakeS3.
+type fakeObject struct {
+	data        []byte
+	contentType string
+	modTime     time.Time
+}
+
+// s3Error is the XML error body of the S3 API.
+type s3Error struct {
+	XMLName    xml.Name `xml:"Error"`
+	Code       string   `xml:"Code"`
+	Message    string   `xml:"Message"`
+	BucketName string   `xml:"BucketName,omitempty"`
+	Key        string   `xml:"Key,omitempty"`
+}
+
+// listBucketResult is the XML body of a ListObjectsV2 response.
+type listBucketResult struct {
+	XMLName               xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name                  string          `xml:"Name"`
+	Prefix                string          `xml:"Prefix"`
+	KeyCount              int             `xml:"KeyCount"`
+	MaxKeys               int             `xml:"MaxKeys"`
+	IsTruncated           bool            `xml:"IsTruncated"`
+	ContinuationToken     string          `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string          `xml:"NextContinuationToken,omitempty"`
+	Contents              []listedContent `xml:"Contents"`
+}
+
+// listedContent is an object in a ListObjectsV2 response.
+type listedContent struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int    `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		f.error(w, http.StatusNotFound, "NoSuchBucket", key)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if key == "" {
+		f.serveBucket(w, r)
+		return
+	}
+	if !f.created {
+		f.error(w, http.StatusNotFound, "NoSuchBucket", key)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := readPayload(r)
+		if err != nil {
+			f.error(w, http.StatusBadRequest, "IncompleteBody", key)
+			return
+		}
+		f.objects[key] = fakeObject{data: data, contentType: r.Header.Get("Content-Type"), modTime: time.Now().UTC().Truncate(time.Second)}
+		w.Header().Set("ETag", etag(data))
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		obj, ok := f.objects[key]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchKey", key)
+			return
+		}
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Header().Set("ETag", etag(obj.data))
+		http.ServeContent(w, r, key, obj.modTime, bytes.NewReader(obj.data))
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", key)
+	}
+}
+
+// serveBucket answers the bucket-level requests: existence checks, creation and listing.
+func (f *fakeS3) serveBucket(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodHead:
+		if !f.created {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPut:
+		f.created = true
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet && r.URL.Query().Has("location"):
+		fmt.Fprint(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></LocationConstraint>`)
+	case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+		f.serveList(w, r.URL.Query())
+	default:
+		f.error(w, http.StatusNotImplemented, "NotImplemented", "")
+	}
+}
+
+// serveList answers a ListObjectsV2 request. Continuation tokens are the last key of the previous page.
+func (f *fakeS3) serveList(w http.ResponseWriter, query url.Values) {
+	f.listPages++
+	keys := make([]string, 0, len(f.objects))
+	for key := range f.objects {
+		if key > query.Get("continuation-token") && strings.HasPrefix(key, query.Get("prefix")) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := listBucketResult{
+		Name:              f.bucket,
+		Prefix:            query.Get("prefix"),
+		MaxKeys:           f.pageSize,
+		ContinuationToken: query.Get("continuation-token"),
+	}
+	if len(keys) > f.pageSize {
+		keys = keys[:f.pageSize]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[len(keys)-1]
+	}
+	for _, key := range keys {
+		obj := f.objects[key]
+		result.Contents = append(result.Contents, listedContent{
+			Key:          key,
+			LastModified: obj.modTime.Format(time.RFC3339),
+			ETag:         etag(obj.data),
+			Size:         len(obj.data),
+			StorageClass: "STANDARD",
+		})
+	}
+	result.KeyCount = len(result.Contents)
+
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(result)
+}
+
+// error writes an S3 error response.
+func (f *fakeS3) error(w http.ResponseWriter, status int, code, key string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	xml.NewEncoder(w).Encode(s3Error{Code: code, Message: code, BucketName: f.bucket, Key: key})
+}
+
+// readPayload reads the body of a PUT request, decoding aws-chunked streaming uploads.
+func readPayload(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+	var data []byte
+	br := bufio.NewReader(r.Body)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			// Trailing checksums, if any, are not verified
+			return data, nil
+		}
+		chunk := make([]byte, size+2) // with its CRLF
+		if _, err := io.ReadFull(br, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+// etag returns a quoted entity tag for data.
+func etag(data []byte) string {
+	return fmt.Sprintf(`"%x"`, len(data))
+}
+
+// newFakeS3 starts a fakeS3 and returns an S3 backend connected to it.
+func newFakeS3(t *testing.T, pageSize int) (*S3, *fakeS3) {
+	t.Helper()
+	fake := &fakeS3{bucket: "uploads", pageSize: pageSize, objects: map[string]fakeObject{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	s, err := NewS3(context.Background(), S3Options{
+		Endpoint:        strings.TrimPrefix(server.URL, "http://"),
+		Region:          "us-east-1",
+		Bucket:          fake.bucket,
+		AccessKeyID:     "test",
+		SecretAccessKey: "testsecret",
+		BaseURL:         "https://cdn.example.com",
+	})
+	if err != nil {
+		t.Fatalf("NewS3: %v", err)
+	}
+	if !fake.created {
+		t.Fatal("NewS3 did not create the missing bucket")
+	}
+	return s, fake
+}
+
+func TestS3PutGetExistsDelete(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newFakeS3(t, 1000)
+	content := []byte("\x89PNG\r\n\x1a\nnot really a png")
+
+	if err := s.Put(ctx, "1718000000.png", bytes.NewReader(content), int64(len(content)), "image/png"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	exists, err := s.Exists(ctx, "1718000000.png")
+	if err != nil || !exists {
+		t.Fatalf("Exists = %v, %v, want true", exists, err)
+	}
+
+	obj, info, err := s.Get(ctx, "1718000000.png")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	got, err := io.ReadAll(obj)
+	obj.Close()
+	if err != nil || !bytes.Equal(got, content) {
+		t.Errorf("Get read %q, %v, want %q", got, err, content)
+	}
+	if info.Size != int64(len(content)) || info.ContentType != "image/png" || info.ModTime.IsZero() {
+		t.Errorf("Get info = %+v", info)
+	}
+
+	// Seeking, as http.ServeContent does for range requests, reads from the offset
+	obj, _, err = s.Get(ctx, "1718000000.png")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _, err := obj.Seek(8, io.SeekStart); err != nil {
+		t.Fatalf("Seek: %v", err)
+	}
+	got, err = io.ReadAll(obj)
+	obj.Close()
+	if err != nil || !bytes.Equal(got, content[8:]) {
+		t.Errorf("read after Seek = %q, %v, want %q", got, err, content[8:])
+	}
+
+	if err := s.Delete(ctx, "1718000000.png"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if exists, err := s.Exists(ctx, "1718000000.png"); err != nil || exists {
+		t.Errorf("Exists after Delete = %v, %v, want false", exists, err)
+	}
+	// Deleting a missing object is not an error
+	if err := s.Delete(ctx, "1718000000.png"); err != nil {
+		t.Errorf("Delete of a missing object: %v", err)
+	}
+}
+
+func TestS3GetMissingIsNotExist(t *testing.T) {
+	s, _ := newFakeS3(t, 1000)
+
+	_, _, err := s.Get(context.Background(), "missing.jpg")
+	if !errors.Is(err, ErrNotExist) {
+		t.Errorf("Get of a missing object: error = %v, want ErrNotExist", err)
+	}
+	exists, err := s.Exists(context.Background(), "missing.jpg")
+	if err != nil || exists {
+		t.Errorf("Exists of a missing object = %v, %v, want false, nil", exists, err)
+	}
+}
+
+func TestS3RejectsInvalidKeys(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newFakeS3(t, 1000)
+
+	for _, key := range []string{"", "/abs.jpg", "../escape.jpg", "a/./b.jpg", `a\b.jpg`} {
+		if err := s.Put(ctx, key, strings.NewReader("x"), 1, "image/jpeg"); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+		if _, _, err := s.Get(ctx, key); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Get(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+		if _, err := s.Exists(ctx, key); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Exists(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+		if err := s.Delete(ctx, key); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Delete(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
+
+func TestS3ListPaginates(t *testing.T) {
+	ctx := context.Background()
+	s, fake := newFakeS3(t, 2)
+
+	want := map[string]int64{}
+	for i := 0; i < 5; i++ {
+		key := fmt.Sprintf("%d.jpg", 1718000000+i)
+		content := strings.Repeat("x", i+1)
+		if err := s.Put(ctx, key, strings.NewReader(content), int64(len(content)), "image/jpeg"); err != nil {
+			t.Fatalf("Put(%q): %v", key, err)
+		}
+		want[key] = int64(len(content))
+	}
+
+	got := map[string]int64{}
+	err := s.List(ctx, func(key string, info ObjectInfo) error {
+		got[key] = info.Size
+		if info.ModTime.IsZero() {
+			t.Errorf("List(%q) has no modification time", key)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Errorf("List returned %v, want %v", got, want)
+	}
+	for key, size := range want {
+		if got[key] != size {
+			t.Errorf("List size of %q = %d, want %d", key, got[key], size)
+		}
+	}
+	if fake.listPages != 3 {
+		t.Errorf("List fetched %d pages, want 3", fake.listPages)
+	}
+
+	// An error from fn stops the listing and is returned
+	errStop := errors.New("stop")
+	calls := 0
+	err = s.List(ctx, func(string, ObjectInfo) error {
+		calls++
+		return errStop
+	})
+	if !errors.Is(err, errStop) || calls != 1 {
+		t.Errorf("List with a failing fn = %v after %d calls, want errStop after 1", err, calls)
+	}
+}
+
+func TestS3URL(t *testing.T) {
+	s, _ := newFakeS3(t, 1000)
+	if got := s.URL("1718000000.jpg"); got != "https://cdn.example.com/1718000000.jpg" {
+		t.Errorf("URL = %q", got)
+	}
+}
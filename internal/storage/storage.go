@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// ErrNotExist is returned by Get when no object is stored under the key.
+var ErrNotExist = errors.New("object does not exist")
+
+// ErrInvalidKey is returned for keys that are empty or try to escape the storage root.
+var ErrInvalidKey = errors.New("invalid object key")
+
+// ObjectInfo describes a stored object.
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+}
+
+// Storage stores uploaded files under slash-separated keys such as "1718000000.jpg".
+type Storage interface {
+	// Put stores the contents of r under key, replacing any existing object.
+	// size may be -1 when unknown.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get opens the object stored under key. The caller must close it.
+	Get(ctx context.Context, key string) (io.ReadSeekCloser, ObjectInfo, error)
+	// Delete removes the object stored under key. Missing objects are not an error.
+	Delete(ctx context.Context, key string) error
+	// Exists reports whether an object is stored under key.
+	Exists(ctx context.Context, key string) (bool, error)
+	// List calls fn for every stored object, in no particular order.
+	// An error returned by fn stops the listing and is returned.
+	List(ctx context.Context, fn func(key string, info ObjectInfo) error) error
+	// URL returns the public URL of key.
+	URL(key string) string
+}
+
+// validKey reports whether key is a clean relative path that stays inside the storage root.
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	if path.Clean(key) != key {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "." || part == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// imageContentTypes maps the extensions of the image formats uploads are
+// stored in to their content types.
+var imageContentTypes = map[string]string{
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".webp": "image/webp",
+	".gif":  "image/gif",
+	".avif": "image/avif",
+}
+
+// imageContentType returns the content type of key by its extension, and
+// false when key is not named as one of the image formats uploads are stored in.
+func imageContentType(key string) (string, bool) {
+	contentType, ok := imageContentTypes[strings.ToLower(path.Ext(key))]
+	return contentType, ok
+}
+
+// joinURL appends key to baseURL.
+func joinURL(baseURL, key string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + key
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// publicBaseURLKey is the context key holding the public base URL of a request.
+type publicBaseURLKey struct{}
+
+// WithPublicBaseURL returns a copy of ctx carrying baseURL, the origin and path
+// prefix clients reached the API at.
+func WithPublicBaseURL(ctx context.Context, baseURL string) context.Context {
+	return context.WithValue(ctx, publicBaseURLKey{}, baseURL)
+}
+
+// PublicBaseURLFrom returns the public base URL stored by WithPublicBaseURL, or "" if there is none.
+func PublicBaseURLFrom(ctx context.Context) string {
+	baseURL, _ := ctx.Value(publicBaseURLKey{}).(string)
+	return baseURL
+}
+
+// PublicBaseURL returns the origin and path prefix, such as "https://example.com/blog",
+// that the client used to reach the API. The Forwarded header, or else the
+// X-Forwarded-Proto, X-Forwarded-Host and X-Forwarded-Prefix headers, are only
+// honored when the request comes straight from one of the trusted proxies;
+// otherwise they could be forged by any client. Of those headers, only the
+// last element, added by that proxy, is used: the ones before it come from
+// further up the chain, the client included.
+func PublicBaseURL(r *http.Request, trusted []*net.IPNet) string {
+	scheme, host, prefix := "http", r.Host, ""
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	if IsTrustedPeer(r, trusted) {
+		if proto, fwdHost, ok := parseForwarded(lastHeaderValue(r, "Forwarded")); ok {
+			scheme, host = valueOr(proto, scheme), valueOr(fwdHost, host)
+		} else {
+			scheme = valueOr(lastHeaderValue(r, "X-Forwarded-Proto"), scheme)
+			host = valueOr(lastHeaderValue(r, "X-Forwarded-Host"), host)
+		}
+		prefix = strings.TrimSuffix(lastHeaderValue(r, "X-Forwarded-Prefix"), "/")
+	}
+
+	scheme = strings.ToLower(scheme)
+	if scheme != "http" && scheme != "https" {
+		scheme = "http"
+	}
+	if !hostPattern.MatchString(host) {
+		host = r.Host
+	}
+	if prefix != "" && !prefixPattern.MatchString(prefix) {
+		prefix = ""
+	}
+	return scheme + "://" + host + prefix
+}
+
+// IsTrustedPeer reports whether the request's immediate peer is in one of the trusted networks.
+func IsTrustedPeer(r *http.Request, trusted []*net.IPNet) bool {
+	if len(trusted) == 0 {
+		return false
+	}
+	addr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		addr = r.RemoteAddr
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// hostPattern matches a host with an optional port, including bracketed IPv6 addresses.
+var hostPattern = regexp.MustCompile(`^([a-zA-Z0-9.-]+|\[[0-9a-fA-F:.]+\])(:[0-9]{1,5})?$`)
+
+// prefixPattern matches a path prefix such as "/blog/api".
+var prefixPattern = regexp.MustCompile(`^(/[a-zA-Z0-9._~-]+)+$`)
+
+// parseForwarded returns the proto and host parameters of a single element of
+// an RFC 7239 Forwarded header.
+func parseForwarded(element string) (proto, host string, ok bool) {
+	for _, pair := range strings.Split(element, ";") {
+		name, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found {
+			continue
+		}
+		value = strings.Trim(value, `"`)
+		switch strings.ToLower(name) {
+		case "proto":
+			proto = value
+		case "host":
+			host = value
+		}
+	}
+	return proto, host, proto != "" || host != ""
+}
+
+// lastHeaderValue returns the last comma-separated value of header across all
+// of its lines. Proxies append to these headers as the request passes through
+// them, so the last value is the one added by the nearest proxy.
+func lastHeaderValue(r *http.Request, header string) string {
+	values := r.Header.Values(header)
+	if len(values) == 0 {
+		return ""
+	}
+	last := values[len(values)-1]
+	if i := strings.LastIndexByte(last, ','); i >= 0 {
+		last = last[i+1:]
+	}
+	return strings.TrimSpace(last)
+}
+
+// valueOr returns v, or def when v is empty.
+func valueOr(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
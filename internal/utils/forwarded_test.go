@@ -0,0 +1,196 @@
+package utils
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPublicBaseURL(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	trusted := []*net.IPNet{proxies}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		tls        bool
+		headers    [][2]string
+		want       string
+	}{
+		{
+			name:       "direct request",
+			remoteAddr: "203.0.113.7:5000",
+			want:       "http://api.internal",
+		},
+		{
+			name:       "direct tls request",
+			remoteAddr: "203.0.113.7:5000",
+			tls:        true,
+			want:       "https://api.internal",
+		},
+		{
+			name:       "untrusted peer cannot set forwarded",
+			remoteAddr: "203.0.113.7:5000",
+			headers:    [][2]string{{"Forwarded", "proto=https;host=evil.example"}},
+			want:       "http://api.internal",
+		},
+		{
+			name:       "untrusted peer cannot set x-forwarded",
+			remoteAddr: "203.0.113.7:5000",
+			headers: [][2]string{
+				{"X-Forwarded-Proto", "https"},
+				{"X-Forwarded-Host", "evil.example"},
+				{"X-Forwarded-Prefix", "/phish"},
+			},
+			want: "http://api.internal",
+		},
+		{
+			name:       "trusted proxy forwarded",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    [][2]string{{"Forwarded", `for=198.51.100.1;proto=https;host="blog.example.com"`}},
+			want:       "https://blog.example.com",
+		},
+		{
+			name:       "trusted proxy x-forwarded",
+			remoteAddr: "10.0.0.2:5000",
+			headers: [][2]string{
+				{"X-Forwarded-Proto", "https"},
+				{"X-Forwarded-Host", "blog.example.com"},
+				{"X-Forwarded-Prefix", "/api/"},
+			},
+			want: "https://blog.example.com/api",
+		},
+		{
+			name:       "forwarded takes precedence",
+			remoteAddr: "10.0.0.2:5000",
+			headers: [][2]string{
+				{"Forwarded", "proto=https;host=blog.example.com"},
+				{"X-Forwarded-Host", "other.example.com"},
+			},
+			want: "https://blog.example.com",
+		},
+		{
+			name:       "spoofed forwarded element appended to by the proxy",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    [][2]string{{"Forwarded", "proto=https;host=evil.example, proto=https;host=blog.example.com"}},
+			want:       "https://blog.example.com",
+		},
+		{
+			name:       "spoofed forwarded line followed by the proxy's line",
+			remoteAddr: "10.0.0.2:5000",
+			headers: [][2]string{
+				{"Forwarded", "host=evil.example"},
+				{"Forwarded", "proto=https;host=blog.example.com"},
+			},
+			want: "https://blog.example.com",
+		},
+		{
+			name:       "spoofed x-forwarded values appended to by the proxy",
+			remoteAddr: "10.0.0.2:5000",
+			headers: [][2]string{
+				{"X-Forwarded-Proto", "http, https"},
+				{"X-Forwarded-Host", "evil.example, blog.example.com"},
+				{"X-Forwarded-Prefix", "/phish, /api"},
+			},
+			want: "https://blog.example.com/api",
+		},
+		{
+			name:       "spoofed x-forwarded line followed by the proxy's line",
+			remoteAddr: "10.0.0.2:5000",
+			headers: [][2]string{
+				{"X-Forwarded-Host", "evil.example"},
+				{"X-Forwarded-Host", "blog.example.com"},
+			},
+			want: "http://blog.example.com",
+		},
+		{
+			name:       "forwarded element without proto or host falls back to x-forwarded",
+			remoteAddr: "10.0.0.2:5000",
+			headers: [][2]string{
+				{"Forwarded", "for=198.51.100.1"},
+				{"X-Forwarded-Host", "blog.example.com"},
+			},
+			want: "http://blog.example.com",
+		},
+		{
+			name:       "malformed host is ignored",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    [][2]string{{"X-Forwarded-Host", "blog.example.com/evil"}},
+			want:       "http://api.internal",
+		},
+		{
+			name:       "unknown scheme is ignored",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    [][2]string{{"X-Forwarded-Proto", "javascript"}},
+			want:       "http://api.internal",
+		},
+		{
+			name:       "malformed prefix is ignored",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    [][2]string{{"X-Forwarded-Prefix", "//evil.example"}},
+			want:       "http://api.internal",
+		},
+		{
+			name:       "ipv6 host with port",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    [][2]string{{"X-Forwarded-Host", "[2001:db8::1]:8443"}},
+			want:       "http://[2001:db8::1]:8443",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "http://api.internal/images/1.jpg", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.tls {
+				r.TLS = &tls.ConnectionState{}
+			}
+			for _, h := range tt.headers {
+				r.Header.Add(h[0], h[1])
+			}
+			if got := PublicBaseURL(r, trusted); got != tt.want {
+				t.Errorf("PublicBaseURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPublicBaseURLWithoutTrustedProxies(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "http://api.internal/", nil)
+	r.RemoteAddr = "127.0.0.1:5000"
+	r.Header.Set("X-Forwarded-Host", "evil.example")
+	if got := PublicBaseURL(r, nil); got != "http://api.internal" {
+		t.Errorf("PublicBaseURL() = %q, want http://api.internal", got)
+	}
+}
+
+func TestIsTrustedPeer(t *testing.T) {
+	_, v4, _ := net.ParseCIDR("10.0.0.0/8")
+	_, v6, _ := net.ParseCIDR("fd00::/8")
+	trusted := []*net.IPNet{v4, v6}
+
+	tests := []struct {
+		remoteAddr string
+		want       bool
+	}{
+		{"10.1.2.3:80", true},
+		{"10.1.2.3", true},
+		{"[fd00::1]:80", true},
+		{"11.0.0.1:80", false},
+		{"[2001:db8::1]:80", false},
+		{"not an address", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = tt.remoteAddr
+		if got := IsTrustedPeer(r, trusted); got != tt.want {
+			t.Errorf("IsTrustedPeer(%q) = %v, want %v", tt.remoteAddr, got, tt.want)
+		}
+	}
+}
@@ -7,13 +7,21 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mozillazg/go-unidecode"
 )
 
 // HTTPError represents a custom HTTP error response.
 type HTTPError struct {
-	Code    int    `json:"code"`
+	Code    int          `json:"code"`
+	Message string       `json:"message"`
+	Details string       `json:"details,omitempty"`
+	Errors  []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError describes why a single request field or query parameter was rejected.
+type FieldError struct {
+	Field   string `json:"field"`
 	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
 }
 
 // Error implements the error interface for HTTPError.
@@ -36,6 +44,15 @@ func NewHTTPError(code int, message string, err error) *HTTPError {
 	return httpErr
 }
 
+// NewValidationError creates a 400 HTTPError listing the rejected fields.
+func NewValidationError(message string, errs []FieldError) *HTTPError {
+	return &HTTPError{
+		Code:    http.StatusBadRequest,
+		Message: message,
+		Errors:  errs,
+	}
+}
+
 // CustomHTTPErrorHandler handles custom HTTP errors.
 func CustomHTTPErrorHandler(err error, c echo.Context) {
 	var report *echo.HTTPError
@@ -77,9 +94,11 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 	}
 }
 
-// GenerateSlug converts a string to a URL-friendly slug.
+// GenerateSlug converts a string to a URL-friendly slug. Non-ASCII letters
+// are transliterated, so "Crème brûlée" becomes "creme-brulee" and "Привет
+// мир" becomes "privet-mir".
 func GenerateSlug(s string) string {
-	s = strings.ToLower(s)
+	s = strings.ToLower(unidecode.Unidecode(s))
 	// Replace non-alphanumeric characters with hyphens
 	reg := regexp.MustCompile("[^a-z0-9]+")
 	s = reg.ReplaceAllString(s, "-")
@@ -87,10 +106,3 @@ func GenerateSlug(s string) string {
 	s = strings.Trim(s, "-")
 	return s
 }
-
-func GetPublicHost(c echo.Context) string {
-	if c.Request().TLS != nil {
-		return "https://" + c.Request().Host
-	}
-	return "http://" + c.Request().Host
-}
@@ -0,0 +1,32 @@
+package utils
+
+import "testing"
+
+func TestGenerateSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "ascii", in: "Hello World", want: "hello-world"},
+		{name: "punctuation collapses", in: "  Go: tips & tricks!! ", want: "go-tips-tricks"},
+		{name: "digits kept", in: "Top 10 APIs of 2024", want: "top-10-apis-of-2024"},
+		{name: "accents", in: "Crème brûlée", want: "creme-brulee"},
+		{name: "german", in: "Straße über Köln", want: "strasse-uber-koln"},
+		{name: "cyrillic", in: "Привет мир", want: "privet-mir"},
+		{name: "cyrillic yo", in: "Ёлка", want: "iolka"},
+		{name: "greek", in: "Καλημέρα", want: "kalemera"},
+		{name: "polish", in: "Zażółć gęślą jaźń", want: "zazolc-gesla-jazn"},
+		{name: "symbols only", in: "!!! ???", want: ""},
+		{name: "emoji only", in: "🎉🎉", want: ""},
+		{name: "empty", in: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GenerateSlug(tt.in); got != tt.want {
+				t.Errorf("GenerateSlug(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
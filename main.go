@@ -1,17 +1,21 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/AdongoJr2/technoprise-backend/internal/controllers"
+	appmiddleware "github.com/AdongoJr2/technoprise-backend/internal/middleware"
 	"github.com/AdongoJr2/technoprise-backend/internal/router"
 	"github.com/AdongoJr2/technoprise-backend/internal/services"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"log"
 	"net/http"
-	"strings"
+	"os"
 
 	"github.com/AdongoJr2/technoprise-backend/config"
+	// Registers the schema's defaults, validators and interceptors
+	_ "github.com/AdongoJr2/technoprise-backend/ent/runtime"
 )
 
 func main() {
@@ -20,6 +24,7 @@ func main() {
 
 	// Initialize Echo server
 	e := echo.New()
+	e.IPExtractor = appmiddleware.ClientIPExtractor(cfg.TrustedProxies)
 
 	// Middleware
 	e.Use(middleware.Logger())
@@ -27,29 +32,9 @@ func main() {
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"}, // Allow all origins for development. Restrict in production.
 	}))
+	e.Use(appmiddleware.PublicBaseURL(cfg.TrustedProxies))
 
-	// Configure static file serving
-	uploadsDir := "./uploads" // Local directory where images are stored
-	publicPath := "/images"   // Public URL path
-
-	// Prevent directory listing
-	e.Use(middleware.StaticWithConfig(middleware.StaticConfig{
-		Root:   uploadsDir,
-		Browse: false,
-		Index:  "",
-	}))
-
-	// Set proper cache headers
-	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			if strings.HasPrefix(c.Path(), "/images/") {
-				c.Response().Header().Set("Cache-Control", "public, max-age=31536000")
-			}
-			return next(c)
-		}
-	})
-
-	e.Static(publicPath, uploadsDir)
+	publicPath := "/images" // Public URL path of uploaded images
 
 	// Initialize database and Ent client
 	client, err := config.ConnectDB(cfg)
@@ -63,20 +48,95 @@ func main() {
 		}
 	}()
 
+	// Initialize upload storage (local directory or S3-compatible bucket)
+	store, err := config.NewStorage(context.Background(), cfg, publicPath)
+	if err != nil {
+		log.Fatalf("Failed to initialize storage: %v", err)
+	}
+
 	// Initialize services and handlers with the Ent client
-	imageService := services.NewImageService(
-		uploadsDir, // Local storage directory
-		publicPath, // Base URL for accessing images
-	)
+	imageService := services.NewImageService(client, store, services.ImageOptions{
+		PublicPath: publicPath,
+		Limits: services.ImageLimits{
+			MaxBytes:     cfg.MaxUploadBytes,
+			MaxDimension: cfg.MaxImageDimension,
+			MaxPixels:    cfg.MaxImagePixels,
+		},
+		Variants: imageVariants(cfg.ImageVariants),
+		Transform: services.TransformConfig{
+			CacheDir:      cfg.ImageCacheDir,
+			CacheMaxBytes: cfg.ImageCacheMaxBytes,
+			CacheMaxAge:   cfg.ImageCacheMaxAge,
+			Sizes:         cfg.ImageTransformSizes,
+			Qualities:     cfg.ImageTransformQualities,
+		},
+	})
+
 	blogPostService := services.NewBlogPostService(client, imageService)
-	blogPostController := controllers.NewBlogPostHandler(blogPostService, imageService)
+
+	// Run a maintenance command, such as gc-uploads, instead of the server when one is given
+	if len(os.Args) > 1 {
+		if err := runCommand(context.Background(), os.Args[1:], cfg, imageService, blogPostService); err != nil {
+			log.Fatalf("%s: %v", os.Args[1], err)
+		}
+		return
+	}
+
+	// Periodically delete uploads that nothing refers to
+	if cfg.UploadGCInterval > 0 {
+		imageService.StartOrphanCollector(context.Background(), cfg.UploadGCInterval, services.OrphanOptions{
+			GracePeriod: cfg.UploadGCGracePeriod,
+		})
+	}
+
+	// Periodically delete posts that have been in the trash too long
+	if cfg.TrashPurgeInterval > 0 {
+		blogPostService.StartTrashPurger(context.Background(), cfg.TrashPurgeInterval, cfg.TrashRetention)
+	}
+
+	authService := services.NewAuthService(client, cfg.SessionTTL)
+	if err := authService.EnsureAdmin(context.Background(), cfg.AdminEmail, cfg.AdminPassword); err != nil {
+		log.Fatalf("Failed to create initial admin user: %v", err)
+	}
+	authorService := services.NewAuthorService(client, imageService)
+	categoryService := services.NewCategoryService(client)
+	tagService := services.NewTagService(client)
+	mediaService := services.NewMediaService(client, imageService)
+	authController := controllers.NewAuthHandler(authService)
+	pagination := controllers.Pagination{DefaultLimit: cfg.DefaultPageSize, MaxLimit: cfg.MaxPageSize}
+	blogPostController := controllers.NewBlogPostHandler(blogPostService, imageService, pagination)
+	authorController := controllers.NewAuthorHandler(authorService, blogPostService, imageService, pagination)
+	categoryController := controllers.NewCategoryHandler(categoryService)
+	tagController := controllers.NewTagHandler(tagService)
+	imageController := controllers.NewImageHandler(imageService)
+	mediaController := controllers.NewMediaHandler(mediaService, imageService, pagination)
 
 	// Register routes
 	e.GET("/", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Welcome to Technoprise APIs")
 	})
-	router.RegisterRoutes(e, blogPostController)
+	// Serve uploaded images through the storage backend
+	e.GET(publicPath+"/*", imageController.ServeImage)
+	router.RegisterRoutes(
+		e,
+		authService,
+		authController,
+		blogPostController,
+		authorController,
+		categoryController,
+		tagController,
+		mediaController,
+	)
 
 	// Start server
 	e.Logger.Fatal(e.Start(fmt.Sprintf(":%s", cfg.ServerPort)))
 }
+
+// imageVariants converts the configured image variants for the image service.
+func imageVariants(variants []config.ImageVariant) []services.ImageVariantSpec {
+	specs := make([]services.ImageVariantSpec, len(variants))
+	for i, v := range variants {
+		specs[i] = services.ImageVariantSpec{Name: v.Name, Width: v.Width}
+	}
+	return specs
+}